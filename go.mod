@@ -3,15 +3,18 @@ module github.com/banjuanshu/go-editorjs
 go 1.18
 
 require (
+	github.com/BurntSushi/toml v1.3.2
+	github.com/go-pdf/fpdf v0.6.0
 	github.com/matryer/is v1.4.0
 	github.com/stretchr/testify v1.8.1
 	github.com/tdewolff/minify/v2 v2.12.4
-	gitlab.com/rodrigoodhin/go-editorjs-parser v0.0.0-20211210080121-c41cdf123509
+	github.com/yuin/goldmark v1.5.6
+	golang.org/x/net v0.30.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/tdewolff/parse/v2 v2.6.4 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
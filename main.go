@@ -1,15 +1,28 @@
 package editorjs
 
 import (
+	"github.com/banjuanshu/go-editorjs/parser/asciidoc"
+	"github.com/banjuanshu/go-editorjs/parser/chat"
+	"github.com/banjuanshu/go-editorjs/parser/docx"
+	"github.com/banjuanshu/go-editorjs/parser/epub"
+	"github.com/banjuanshu/go-editorjs/parser/gemtext"
 	"github.com/banjuanshu/go-editorjs/parser/html"
 	"github.com/banjuanshu/go-editorjs/parser/html/bootstrap"
 	"github.com/banjuanshu/go-editorjs/parser/html/bulma"
+	"github.com/banjuanshu/go-editorjs/parser/html/email"
 	"github.com/banjuanshu/go-editorjs/parser/html/sample"
+	"github.com/banjuanshu/go-editorjs/parser/html/semantic"
+	"github.com/banjuanshu/go-editorjs/parser/html/tailwind"
+	"github.com/banjuanshu/go-editorjs/parser/latex"
 	"github.com/banjuanshu/go-editorjs/parser/markdown"
+	"github.com/banjuanshu/go-editorjs/parser/pdf"
+	"github.com/banjuanshu/go-editorjs/parser/rst"
+	"github.com/banjuanshu/go-editorjs/parser/terminal"
 	"github.com/banjuanshu/go-editorjs/support"
 	"github.com/banjuanshu/go-editorjs/support/domain"
-	"log"
-	"strings"
+	"io"
+	"io/fs"
+	"os"
 )
 
 func Bootstrap(jsonStr string) string {
@@ -25,70 +38,260 @@ func Custom(jsonStr, stylePath string) string {
 	return html.Parser(jsonStr, "custom")
 }
 
+func CustomFromReader(jsonStr string, style io.Reader, format string) (string, error) {
+	if err := support.LoadStyleMapFromReader(style, format); err != nil {
+		return "", err
+	}
+	return html.ParserWithLimits(jsonStr, "custom", support.DefaultLimits)
+}
+
+func CustomFromFS(jsonStr string, fsys fs.FS, stylePath string) (string, error) {
+	if err := support.LoadStyleMapFromFS(fsys, stylePath); err != nil {
+		return "", err
+	}
+	return html.ParserWithLimits(jsonStr, "custom", support.DefaultLimits)
+}
+
+func CustomFromStyleMap(jsonStr string, styleMap domain.StyleMap) (string, error) {
+	if err := support.SetStyleMap(styleMap); err != nil {
+		return "", err
+	}
+	return html.ParserWithLimits(jsonStr, "custom", support.DefaultLimits)
+}
+
+func Style(jsonStr, styleName string) string {
+	return html.Parser(jsonStr, styleName)
+}
+
+func RegisterStyle(name string, styleMap domain.StyleMap, renderer support.RendererFactory) error {
+	return support.RegisterStyle(name, styleMap, renderer)
+}
+
+func Styles() []string {
+	return support.Styles()
+}
+
+func SetAssetFS(fsys fs.FS) {
+	support.SetAssetFS(fsys)
+}
+
+func Email(jsonStr string) string {
+	return html.Parser(jsonStr, email.StyleName)
+}
+
 func Sample(jsonStr string) string {
 	return html.Parser(jsonStr, sample.StyleName)
 }
 
-func Markdown(jsonFilePath, outputFilePath string) (err error) {
-	var result []string
+func Semantic(jsonStr string) string {
+	return html.Parser(jsonStr, semantic.StyleName)
+}
+
+func Tailwind(jsonStr string) string {
+	return html.Parser(jsonStr, tailwind.StyleName)
+}
+
+func TailwindProse(jsonStr string) string {
+	return html.Parser(jsonStr, tailwind.ProseStyleName)
+}
+
+// HTML renders the document with the named style within
+// support.DefaultLimits, returning errors where Style logs them.
+func HTML(jsonStr, styleName string) (string, error) {
+	return html.ParserWithLimits(jsonStr, styleName, support.DefaultLimits)
+}
 
-	input, err := support.ReadJsonFile(jsonFilePath)
+func HTMLWithLimits(jsonStr, styleName string, limits support.Limits) (string, error) {
+	return html.ParserWithLimits(jsonStr, styleName, limits)
+}
+
+func HTMLPage(jsonStr, styleName string) (string, error) {
+	return html.Page(jsonStr, styleName, html.PageOptions{Limits: support.DefaultLimits})
+}
+
+// SelfContained renders a complete page that works offline. Local image
+// sources are inlined from images when it isn't nil. Of the built-in styles
+// only sample, semantic and email have the local copies this needs.
+func SelfContained(jsonStr, styleName string, images fs.FS) (string, error) {
+	return html.Page(jsonStr, styleName, html.PageOptions{Limits: support.DefaultLimits, SelfContained: true, Images: images})
+}
+
+func Slides(jsonStr, styleName string, options html.SlideOptions) (string, error) {
+	return html.Slides(jsonStr, styleName, options)
+}
+
+func BundleDir(jsonStr, styleName, dir string, fetcher html.Fetcher) error {
+	b, err := html.NewBundle(jsonStr, styleName, html.BundleOptions{Limits: support.DefaultLimits, Fetcher: fetcher})
+	if err != nil {
+		return err
+	}
+	return b.WriteDir(dir)
+}
+
+func BundleZip(jsonStr, styleName string, w io.Writer, fetcher html.Fetcher) error {
+	b, err := html.NewBundle(jsonStr, styleName, html.BundleOptions{Limits: support.DefaultLimits, Fetcher: fetcher})
+	if err != nil {
+		return err
+	}
+	return b.WriteZip(w)
+}
+
+// EPUB writes the documents as the chapters of an e-book, packaging local
+// images read from images. A nil images keeps every image as a link.
+func EPUB(outputFilePath, title string, images fs.FS, jsonStrs ...string) (err error) {
+	book := epub.Book{Title: title, Fetcher: localFetcher(images), Limits: support.DefaultLimits}
+	for _, jsonStr := range jsonStrs {
+		book.Chapters = append(book.Chapters, epub.Chapter{JSON: jsonStr})
+	}
+
+	out, err := os.Create(outputFilePath)
+	if err != nil {
+		return
+	}
+	defer out.Close()
+
+	return book.Write(out)
+}
+
+func Markdown(jsonFilePath, outputFilePath string) error {
+	return MarkdownWithLimits(jsonFilePath, outputFilePath, support.DefaultLimits)
+}
+
+func MarkdownWithLimits(jsonFilePath, outputFilePath string, limits support.Limits) error {
+	return MarkdownWithRenderer(jsonFilePath, outputFilePath, markdown.Renderer{}, limits)
+}
+
+func MarkdownFlavor(jsonFilePath, outputFilePath string, flavor markdown.Flavor) error {
+	return MarkdownWithRenderer(jsonFilePath, outputFilePath, markdown.New(flavor), support.DefaultLimits)
+}
+
+func MarkdownWithRenderer(jsonFilePath, outputFilePath string, renderer markdown.Renderer, limits support.Limits) (err error) {
+	file, err := os.Open(jsonFilePath)
 	if err != nil {
-		log.Println("It was not possible to read the input json file\n", err)
-	}
-
-	editorJSAST := support.ParseEditorJSON(input)
-
-	for _, el := range editorJSAST.Blocks {
-
-		content := support.PrepareData(el)
-
-		switch el.Type {
-
-		case "header":
-			result = append(result, markdown.Header(content.(*domain.EditorJSDataHeader)))
-		case "paragraph":
-			result = append(result, markdown.Paragraph(content.(*domain.EditorJSDataParagraph)))
-		case "quote":
-			result = append(result, markdown.Quote(content.(*domain.EditorJSDataQuote)))
-		case "warning":
-			result = append(result, markdown.Warning(content.(*domain.EditorJSDataWarning)))
-		case "delimiter":
-			result = append(result, markdown.Delimiter())
-		case "alert":
-			result = append(result, markdown.Alert(content.(*domain.EditorJSDataAlert)))
-		case "list":
-			result = append(result, markdown.List(content.(*domain.EditorJSDataList)))
-		case "checklist":
-			result = append(result, markdown.Checklist(content.(*domain.EditorJSDataChecklist)))
-		case "table":
-			result = append(result, markdown.Table(content.(*domain.EditorJSDataTable)))
-		case "AnyButton":
-			result = append(result, markdown.AnyButton(content.(*domain.EditorJSDataAnyButton)))
-		case "code":
-			result = append(result, markdown.Code(content.(*domain.EditorJSDataCode)))
-		case "raw":
-			result = append(result, markdown.Raw(content.(*domain.EditorJSDataRaw)))
-		case "image":
-			result = append(result, markdown.Image(content.(*domain.EditorJSDataImage)))
-		case "linkTool":
-			result = append(result, markdown.LinkTool(content.(*domain.EditorJSDataLinkTool)))
-		case "attaches":
-			result = append(result, markdown.Attaches(content.(*domain.EditorJSDataAttaches)))
-		case "embed":
-			result = append(result, markdown.Embed(content.(*domain.EditorJSDataEmbed)))
-		case "imageGallery":
-			result = append(result, markdown.ImageGallery(content.(*domain.EditorJSDataImageGallery)))
-		}
-
-	}
-
-	content := strings.Join(result[:], "\n\n")
-
-	err = support.WriteOutputFile(outputFilePath, content, "markdown")
+		return
+	}
+	defer file.Close()
+
+	input, err := support.ReadJsonWithLimits(file, limits)
+	if err != nil {
+		return
+	}
+
+	content, err := renderer.Parse(input, limits)
+	if err != nil {
+		return
+	}
+
+	return support.WriteOutputFile(outputFilePath, content, "markdown")
+}
+
+func LaTeX(jsonFilePath, outputFilePath string) error {
+	return LaTeXWithRenderer(jsonFilePath, outputFilePath, latex.Renderer{Standalone: true}, support.DefaultLimits)
+}
+
+func LaTeXWithRenderer(jsonFilePath, outputFilePath string, renderer latex.Renderer, limits support.Limits) error {
+	return convertFile(jsonFilePath, outputFilePath, renderer.Parse, limits)
+}
+
+func AsciiDoc(jsonFilePath, outputFilePath string) error {
+	return convertFile(jsonFilePath, outputFilePath, asciidoc.Renderer{}.Parse, support.DefaultLimits)
+}
+
+func ReStructuredText(jsonFilePath, outputFilePath string) error {
+	return convertFile(jsonFilePath, outputFilePath, rst.Renderer{}.Parse, support.DefaultLimits)
+}
+
+func Gemtext(jsonFilePath, outputFilePath string) error {
+	return convertFile(jsonFilePath, outputFilePath, gemtext.Renderer{}.Parse, support.DefaultLimits)
+}
+
+func ChatMessages(jsonStr string, platform chat.Platform) ([]string, error) {
+	return chat.New(platform).Messages(jsonStr, support.DefaultLimits)
+}
+
+func Terminal(jsonStr string, width int) (string, error) {
+	return terminal.New(width).Parse(jsonStr, support.DefaultLimits)
+}
+
+// localFetcher reads local images from images only, or returns no fetcher
+// when images is nil.
+func localFetcher(images fs.FS) html.Fetcher {
+	if images == nil {
+		return nil
+	}
+	return html.LocalFetcher{FS: images}
+}
+
+func convertFile(jsonFilePath, outputFilePath string, parse func(string, support.Limits) (string, error), limits support.Limits) (err error) {
+	file, err := os.Open(jsonFilePath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	input, err := support.ReadJsonWithLimits(file, limits)
+	if err != nil {
+		return
+	}
+
+	content, err := parse(input, limits)
+	if err != nil {
+		return
+	}
+
+	return os.WriteFile(outputFilePath, []byte(content), 0644)
+}
+
+func PDF(jsonFilePath, outputFilePath string) error {
+	renderer := pdf.New()
+	renderer.Limits = support.DefaultLimits
+	return PDFWithRenderer(jsonFilePath, outputFilePath, renderer)
+}
+
+func PDFWithRenderer(jsonFilePath, outputFilePath string, renderer pdf.Renderer) (err error) {
+	file, err := os.Open(jsonFilePath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	input, err := support.ReadJsonWithLimits(file, renderer.Limits)
+	if err != nil {
+		return
+	}
+
+	out, err := os.Create(outputFilePath)
+	if err != nil {
+		return
+	}
+	defer out.Close()
+
+	return renderer.Render(input, out)
+}
+
+// DOCX converts the document to a Word file, embedding local images read
+// from images. A nil images keeps every image as a link.
+func DOCX(jsonFilePath, outputFilePath string, images fs.FS) (err error) {
+	renderer := docx.New()
+	renderer.Fetcher = localFetcher(images)
+	renderer.Limits = support.DefaultLimits
+
+	file, err := os.Open(jsonFilePath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	input, err := support.ReadJsonWithLimits(file, renderer.Limits)
+	if err != nil {
+		return
+	}
+
+	out, err := os.Create(outputFilePath)
 	if err != nil {
-		log.Println("It was not possible to write the output markdown file\n", err)
+		return
 	}
+	defer out.Close()
 
-	return
+	return renderer.Render(input, out)
 }
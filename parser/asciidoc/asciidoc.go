@@ -0,0 +1,298 @@
+package asciidoc
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"strings"
+)
+
+type Renderer struct{}
+
+var admonitions = map[string]string{
+	"success": "TIP",
+	"warning": "WARNING",
+	"danger":  "CAUTION",
+	"primary": "IMPORTANT",
+}
+
+func (r Renderer) Header(el *domain.EditorJSDataHeader) string {
+	// Level 0 (a single "=") is the document title, so Editor.js levels
+	// start at the first section level; AsciiDoc stops at level 5.
+	level := el.Level
+	if level < 1 {
+		level = 1
+	} else if level > 5 {
+		level = 5
+	}
+
+	header := strings.Repeat("=", level+1) + " " + Inline(el.Text)
+
+	if el.Anchor != "" {
+		header = "[[" + anchorID(el.Anchor) + "]]\n" + header
+	}
+
+	return header
+}
+
+func (r Renderer) Paragraph(el *domain.EditorJSDataParagraph) string {
+	return role(alignmentRole(el.Alignment)) + block(el.Text)
+}
+
+func (r Renderer) Quote(el *domain.EditorJSDataQuote) string {
+	attributes := "[quote"
+	if el.Caption != "" {
+		attributes += `,"` + strings.ReplaceAll(Inline(el.Caption), `"`, `\"`) + `"`
+	}
+	if alignment := alignmentRole(el.Alignment); alignment != "" {
+		attributes += ",role=" + alignment
+	}
+
+	return attributes + "]\n____\n" + block(el.Text) + "\n____"
+}
+
+func (r Renderer) Warning(el *domain.EditorJSDataWarning) string {
+	return admonition("WARNING", el.Title, el.Message)
+}
+
+func (r Renderer) Delimiter() string {
+	return "'''"
+}
+
+func (r Renderer) Alert(el *domain.EditorJSDataAlert) string {
+	kind, ok := admonitions[el.Type]
+	if !ok {
+		kind = "NOTE"
+	}
+
+	return admonition(kind, "", el.Message)
+}
+
+func (r Renderer) List(el *domain.EditorJSDataList) (string, error) {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return "", err
+	}
+
+	marker := "."
+	if el.Style == "unordered" {
+		marker = "*"
+	}
+
+	return nestedList(itemsList, marker, 1), nil
+}
+
+func nestedList(items []domain.NestedListItem, marker string, depth int) string {
+	var result []string
+
+	for _, item := range items {
+		result = append(result, strings.Repeat(marker, depth)+" "+block(item.Content))
+
+		if len(item.Items) > 0 {
+			result = append(result, nestedList(item.Items, marker, depth+1))
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Checklist(el *domain.EditorJSDataChecklist) string {
+	var result []string
+
+	for _, item := range el.Items {
+		box := "[ ]"
+		if item.Checked {
+			box = "[x]"
+		}
+		result = append(result, "* "+box+" "+block(item.Text))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Table(el *domain.EditorJSDataTable) string {
+	columns := 0
+	for _, line := range el.Content {
+		if len(line) > columns {
+			columns = len(line)
+		}
+	}
+
+	if columns == 0 {
+		return ""
+	}
+
+	attributes := `[cols="` + strings.TrimSuffix(strings.Repeat("1,", columns), ",") + `"`
+	if el.WithHeadings {
+		attributes += `,options="header"`
+	}
+
+	var result []string
+
+	result = append(result, attributes+"]", "|===")
+
+	for index, line := range el.Content {
+		lineData := ""
+
+		for column := 0; column < columns; column++ {
+			info := ""
+			if column < len(line) {
+				info = strings.ReplaceAll(Inline(line[column]), " +\n", " ")
+			}
+			lineData += "| " + info + " "
+		}
+
+		result = append(result, strings.TrimRight(lineData, " "))
+
+		if index == 0 && el.WithHeadings {
+			result = append(result, "")
+		}
+	}
+
+	result = append(result, "|===")
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return "link:" + urlEscaper.Replace(el.Link) + "[" + linkText(Inline(el.Text), true) + ",role=button]"
+}
+
+func (r Renderer) Code(el *domain.EditorJSDataCode) string {
+	fence := fenceFor(el.Code, "-")
+
+	attributes := "[source]\n"
+	if el.LanguageCode != "" {
+		attributes = "[source," + el.LanguageCode + "]\n"
+	}
+
+	return attributes + fence + "\n" + el.Code + "\n" + fence
+}
+
+func (r Renderer) Raw(el *domain.EditorJSDataRaw) string {
+	fence := fenceFor(el.Html, "+")
+
+	return fence + "\n" + el.Html + "\n" + fence
+}
+
+func (r Renderer) Image(el *domain.EditorJSDataImage) string {
+	url := el.URL
+	if el.File.URL != "" {
+		url = el.File.URL
+	}
+
+	alt := strings.NewReplacer(`]`, ``, `,`, ``, `"`, ``).Replace(inline.PlainText(inline.Parse(el.Caption)))
+
+	attributes := alt
+	if el.Stretched {
+		attributes += `,width=100%`
+	}
+
+	image := "image::" + urlEscaper.Replace(url) + "[" + attributes + "]"
+
+	if el.Caption != "" {
+		image = "." + Inline(el.Caption) + "\n" + image
+	}
+
+	return image
+}
+
+// LinkTool renders the link card as a sidebar with the preview image, the
+// title linking to the page and the description.
+func (r Renderer) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	title := Inline(el.Meta.Title)
+	if title == "" {
+		title = Escape(el.Link)
+	}
+
+	var result []string
+
+	result = append(result, "****")
+
+	if el.Meta.Image.URL != "" {
+		result = append(result, "image:"+urlEscaper.Replace(el.Meta.Image.URL)+"[]", "")
+	}
+
+	result = append(result, "*link:"+urlEscaper.Replace(el.Link)+"["+linkText(title, false)+"]*")
+
+	if el.Meta.Description != "" {
+		result = append(result, "", block(el.Meta.Description))
+	}
+
+	result = append(result, "****")
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Attaches(el *domain.EditorJSDataAttaches) string {
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+
+	return "link:" + urlEscaper.Replace(el.File.URL) + "[" + linkText(Escape(name), false) + "] (" + support.HumanFileSize(el.File.Size) + ")"
+}
+
+func (r Renderer) Embed(el *domain.EditorJSDataEmbed) string {
+	link := "link:" + urlEscaper.Replace(el.Source) + "[" + linkText("Watch on "+Escape(el.Service), false) + "]"
+
+	if el.Caption != "" {
+		return block(el.Caption) + " +\n" + link
+	}
+
+	return link
+}
+
+func (r Renderer) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	var images []string
+
+	for _, url := range el.URLs {
+		images = append(images, "image:"+urlEscaper.Replace(url)+"[]")
+	}
+
+	return strings.Join(images, " ")
+}
+
+func admonition(kind, title, message string) string {
+	var result []string
+
+	if title != "" {
+		result = append(result, "."+Inline(title))
+	}
+
+	result = append(result, "["+kind+"]", "====", block(message), "====")
+
+	return strings.Join(result, "\n")
+}
+
+func alignmentRole(alignment string) string {
+	switch alignment {
+	case "center", "right":
+		return "text-" + alignment
+	}
+	return ""
+}
+
+func role(name string) string {
+	if name == "" {
+		return ""
+	}
+	return "[." + name + "]\n"
+}
+
+// fenceFor returns a block delimiter longer than any line of the content
+// made only of the delimiter character.
+func fenceFor(content, char string) string {
+	longest := 3
+	for _, line := range strings.Split(content, "\n") {
+		if line != "" && strings.Trim(line, char) == "" && len(line) > longest {
+			longest = len(line)
+		}
+	}
+
+	return strings.Repeat(char, longest+1)
+}
+
+func anchorID(anchor string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(anchor), " ", "-"))
+}
@@ -0,0 +1,75 @@
+package asciidoc
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func parse(t *testing.T, blocks string) string {
+	out, err := Renderer{}.Parse(`{"blocks": [`+blocks+`]}`, support.Limits{})
+	assert.NoError(t, err)
+	return out
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a{asterisk}b&#95;c{startsb}1{endsb} \{nbsp} {ca`, Escape(`a*b_c[1] {nbsp} {ca`))
+}
+
+func TestInline(t *testing.T) {
+	assert.Equal(t, "**bold**word, __it**x**__ and ``++a_b++``x link:https://example.com/a%20b[a link]",
+		Inline(`<b>bold</b>word, <i>it<b>x</b></i> and <code class="inline-code">a_b</code>x <a href="https://example.com/a b">a link</a>`))
+	assert.Equal(t, `link:https://example.com["a=b"]`, Inline(`<a href="https://example.com">a=b</a>`))
+}
+
+func TestHeader(t *testing.T) {
+	assert.Equal(t, "== Title", parse(t, `{"type": "header", "data": {"level": 1, "text": "Title"}}`))
+	assert.Equal(t, "[[intro]]\n==== Intro", parse(t, `{"type": "header", "data": {"level": 3, "text": "Intro", "anchor": "Intro"}}`))
+}
+
+func TestParagraphLineStarts(t *testing.T) {
+	assert.Equal(t, "[.text-center]\nfirst +\n{empty}. second", parse(t, `{"type": "paragraph", "data": {"text": "first<br>. second", "alignment": "center"}}`))
+}
+
+func TestAdmonitions(t *testing.T) {
+	assert.Equal(t, ".Careful\n[WARNING]\n====\nHot\n====", parse(t, `{"type": "warning", "data": {"title": "Careful", "message": "Hot"}}`))
+	assert.Equal(t, "[CAUTION]\n====\nBad\n====", parse(t, `{"type": "alert", "data": {"type": "danger", "message": "Bad"}}`))
+	assert.Equal(t, "[NOTE]\n====\nFYI\n====", parse(t, `{"type": "alert", "data": {"type": "info", "message": "FYI"}}`))
+}
+
+func TestNestedList(t *testing.T) {
+	assert.Equal(t, ". one\n.. two\n. three", parse(t, `{"type": "list", "data": {"style": "ordered", "items": [{"content": "one", "items": [{"content": "two", "items": []}]}, {"content": "three", "items": []}]}}`))
+	assert.Equal(t, "* a\n* b", parse(t, `{"type": "list", "data": {"style": "unordered", "items": ["a", "b"]}}`))
+}
+
+func TestTable(t *testing.T) {
+	expected := `[cols="1,1",options="header"]
+|===
+| A | B{vbar}C
+
+| 1 |
+|===`
+
+	assert.Equal(t, expected, parse(t, `{"type": "table", "data": {"withHeadings": true, "content": [["A", "B|C"], ["1"]]}}`))
+}
+
+func TestCode(t *testing.T) {
+	assert.Equal(t, "[source,go]\n----\nfmt.Println()\n----", parse(t, `{"type": "code", "data": {"code": "fmt.Println()", "languageCode": "go"}}`))
+	assert.Equal(t, "[source]\n-----\na\n----\n-----", parse(t, `{"type": "code", "data": {"code": "a\n----"}}`))
+}
+
+func TestImage(t *testing.T) {
+	assert.Equal(t, ".A **cat**\nimage::img/a%20cat.png[A cat]", parse(t, `{"type": "image", "data": {"file": {"url": "img/a cat.png"}, "caption": "A <b>cat</b>"}}`))
+}
+
+func TestLinkTool(t *testing.T) {
+	expected := `****
+image:https://example.com/i.png[]
+
+*link:https://example.com[Example]*
+
+Description
+****`
+
+	assert.Equal(t, expected, parse(t, `{"type": "linkTool", "data": {"link": "https://example.com", "meta": {"title": "Example", "description": "Description", "image": {"url": "https://example.com/i.png"}}}}`))
+}
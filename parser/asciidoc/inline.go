@@ -0,0 +1,100 @@
+package asciidoc
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"regexp"
+	"strings"
+)
+
+// textEscaper replaces the characters that start AsciiDoc markup with their
+// built-in attribute references, or character references where AsciiDoc has
+// no attribute for them.
+var textEscaper = strings.NewReplacer(
+	`\`, `{backslash}`,
+	`*`, `{asterisk}`,
+	"`", `{backtick}`,
+	`^`, `{caret}`,
+	`~`, `{tilde}`,
+	`+`, `{plus}`,
+	`[`, `{startsb}`,
+	`]`, `{endsb}`,
+	`|`, `{vbar}`,
+	`<`, `{lt}`,
+	`>`, `{gt}`,
+	`&`, `{amp}`,
+	`_`, `&#95;`,
+	`#`, `&#35;`,
+	" ", `{nbsp}`,
+)
+
+var urlEscaper = strings.NewReplacer(
+	` `, `%20`,
+	`[`, `%5B`,
+	`]`, `%5D`,
+)
+
+// attributePattern matches text AsciiDoc would replace with an attribute.
+var attributePattern = regexp.MustCompile(`\{[\w-]+\}`)
+
+// lineStartPattern matches the line starts AsciiDoc reads as block syntax.
+var lineStartPattern = regexp.MustCompile(`^([=.\-/:'<]|\d+\.|[A-Z]+: )`)
+
+var markup = inline.Markup{
+	Escape: Escape,
+	Delimiters: map[inline.Kind][2]string{
+		inline.Bold:      {"**", "**"},
+		inline.Italic:    {"__", "__"},
+		inline.Underline: {"[.underline]##", "##"},
+		inline.Strike:    {"[.line-through]##", "##"},
+		inline.Mark:      {"##", "##"},
+	},
+	Code: func(text string) string {
+		return "``++" + strings.ReplaceAll(text, "\n", " ") + "++``"
+	},
+	Link: func(inner, href string) string {
+		return "link:" + urlEscaper.Replace(href) + "[" + linkText(inner, false) + "]"
+	},
+	Break: " +\n",
+}
+
+// Escape protects AsciiDoc markup characters in plain text.
+func Escape(s string) string {
+	var result strings.Builder
+
+	last := 0
+	for _, match := range attributePattern.FindAllStringIndex(s, -1) {
+		result.WriteString(textEscaper.Replace(s[last:match[0]]) + `\` + s[match[0]:match[1]])
+		last = match[1]
+	}
+	result.WriteString(textEscaper.Replace(s[last:]))
+
+	return result.String()
+}
+
+// Inline converts the inline HTML of Editor.js text into AsciiDoc.
+func Inline(s string) string {
+	return markup.Convert(s)
+}
+
+// block converts text that fills whole lines, keeping lines from being read
+// as headings, list items or other block syntax.
+func block(s string) string {
+	lines := strings.Split(Inline(s), "\n")
+
+	for i, line := range lines {
+		if lineStartPattern.MatchString(line) {
+			lines[i] = "{empty}" + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// linkText quotes link text AsciiDoc would read as an attribute list, which
+// it does when the text contains an equals sign or attributes follow it.
+func linkText(text string, attributes bool) string {
+	if !attributes && !strings.Contains(text, "=") {
+		return text
+	}
+	return `"` + strings.ReplaceAll(text, `"`, `&#34;`) + `"`
+}
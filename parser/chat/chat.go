@@ -0,0 +1,279 @@
+package chat
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type Renderer struct {
+	Platform Platform
+	// Limit overrides the platform's message length cap.
+	Limit int
+}
+
+var alertIcons = map[string]string{
+	"info":    "ℹ️",
+	"success": "✅",
+	"warning": "⚠️",
+	"danger":  "⛔",
+}
+
+func New(platform Platform) Renderer {
+	return Renderer{Platform: platform}
+}
+
+func (r Renderer) Inline(s string) string {
+	return r.Platform.dialect().markup.Convert(s)
+}
+
+// block converts text that fills whole lines.
+func (r Renderer) block(s string) string {
+	text := r.Inline(s)
+
+	lineStart := r.Platform.dialect().lineStart
+	if lineStart == nil {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if lineStart.MatchString(line) {
+			lines[i] = `\` + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (r Renderer) Header(el *domain.EditorJSDataHeader) string {
+	level := el.Level
+	if level < 1 || level > 6 {
+		level = 2
+	}
+
+	return r.Platform.dialect().header(level, strings.ReplaceAll(r.Inline(el.Text), "\n", " "))
+}
+
+func (r Renderer) Paragraph(el *domain.EditorJSDataParagraph) string {
+	return r.block(el.Text)
+}
+
+func (r Renderer) Quote(el *domain.EditorJSDataQuote) string {
+	quote := r.block(el.Text)
+
+	if el.Caption != "" {
+		quote += "\n— " + r.Inline(el.Caption)
+	}
+
+	return r.Platform.dialect().quote(quote)
+}
+
+func (r Renderer) Warning(el *domain.EditorJSDataWarning) string {
+	message := r.block(el.Message)
+
+	if el.Title != "" {
+		message = r.Platform.dialect().bold(r.Inline(el.Title)) + "\n" + message
+	}
+
+	return "⚠️ " + message
+}
+
+func (r Renderer) Delimiter() string {
+	return "———"
+}
+
+func (r Renderer) Alert(el *domain.EditorJSDataAlert) string {
+	icon, ok := alertIcons[el.Type]
+	if !ok {
+		icon = "📌"
+	}
+
+	return icon + " " + r.block(el.Message)
+}
+
+func (r Renderer) List(el *domain.EditorJSDataList) (string, error) {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return "", err
+	}
+
+	return r.nestedList(itemsList, el.Style == "unordered", 0), nil
+}
+
+// nestedList indents nested items; chat platforms without Markdown lists
+// show the markers as plain text.
+func (r Renderer) nestedList(items []domain.NestedListItem, unordered bool, depth int) string {
+	var result []string
+
+	d := r.Platform.dialect()
+
+	for i, item := range items {
+		marker := d.bullets[depth%len(d.bullets)]
+		if !unordered {
+			marker = strconv.Itoa(i+1) + "."
+		}
+
+		indent := strings.Repeat(" ", depth*4)
+		if d.markdownLists {
+			indent = strings.Repeat(" ", depth*2)
+		}
+
+		content := strings.ReplaceAll(r.Inline(item.Content), "\n", "\n"+indent+strings.Repeat(" ", len(marker)+1))
+		result = append(result, indent+marker+" "+content)
+
+		if len(item.Items) > 0 {
+			result = append(result, r.nestedList(item.Items, unordered, depth+1))
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Checklist(el *domain.EditorJSDataChecklist) string {
+	var result []string
+
+	for _, item := range el.Items {
+		box := "☐"
+		if item.Checked {
+			box = "☑"
+		}
+		result = append(result, box+" "+r.Inline(item.Text))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// Table is laid out as aligned columns in a code block, as chat platforms
+// have no tables.
+func (r Renderer) Table(el *domain.EditorJSDataTable) string {
+	var widths []int
+
+	rows := make([][]string, len(el.Content))
+	for i, line := range el.Content {
+		for column, info := range line {
+			cell := strings.Join(strings.Fields(inline.PlainText(inline.Parse(info))), " ")
+			rows[i] = append(rows[i], cell)
+
+			if column == len(widths) {
+				widths = append(widths, 0)
+			}
+			if width := utf8.RuneCountInString(cell); width > widths[column] {
+				widths[column] = width
+			}
+		}
+	}
+
+	if len(widths) == 0 {
+		return ""
+	}
+
+	var result []string
+
+	for i, row := range rows {
+		var cells []string
+		for column, width := range widths {
+			cell := ""
+			if column < len(row) {
+				cell = row[column]
+			}
+			cells = append(cells, cell+strings.Repeat(" ", width-utf8.RuneCountInString(cell)))
+		}
+
+		result = append(result, strings.TrimRight(strings.Join(cells, " | "), " "))
+
+		if i == 0 && el.WithHeadings {
+			var rule []string
+			for _, width := range widths {
+				rule = append(rule, strings.Repeat("-", width))
+			}
+			result = append(result, strings.Join(rule, "-+-"))
+		}
+	}
+
+	return r.Platform.dialect().codeBlock(strings.Join(result, "\n"), "")
+}
+
+func (r Renderer) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return r.Platform.dialect().link(r.Inline(el.Text), el.Link)
+}
+
+func (r Renderer) Code(el *domain.EditorJSDataCode) string {
+	return r.Platform.dialect().codeBlock(el.Code, el.LanguageCode)
+}
+
+func (r Renderer) Raw(el *domain.EditorJSDataRaw) string {
+	return r.Platform.dialect().codeBlock(el.Html, "html")
+}
+
+// Image links to the image, which the platforms unfurl as a preview.
+func (r Renderer) Image(el *domain.EditorJSDataImage) string {
+	url := el.URL
+	if el.File.URL != "" {
+		url = el.File.URL
+	}
+
+	d := r.Platform.dialect()
+
+	caption := r.Inline(el.Caption)
+	if caption == "" {
+		caption = d.escape(url)
+	}
+
+	return d.link(caption, url)
+}
+
+func (r Renderer) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	d := r.Platform.dialect()
+
+	title := r.Inline(el.Meta.Title)
+	if title == "" {
+		title = d.escape(el.Link)
+	}
+
+	link := d.link(d.bold(title), el.Link)
+
+	if el.Meta.Description != "" {
+		link += "\n" + r.block(el.Meta.Description)
+	}
+
+	return link
+}
+
+func (r Renderer) Attaches(el *domain.EditorJSDataAttaches) string {
+	d := r.Platform.dialect()
+
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+
+	return "📎 " + d.link(d.escape(name), el.File.URL) + " (" + support.HumanFileSize(el.File.Size) + ")"
+}
+
+func (r Renderer) Embed(el *domain.EditorJSDataEmbed) string {
+	d := r.Platform.dialect()
+
+	link := d.link("Watch on "+d.escape(el.Service), el.Source)
+
+	if el.Caption != "" {
+		return r.block(el.Caption) + "\n" + link
+	}
+
+	return link
+}
+
+func (r Renderer) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	d := r.Platform.dialect()
+
+	var result []string
+
+	for index, url := range el.URLs {
+		result = append(result, d.link("Image "+strconv.Itoa(index+1), url))
+	}
+
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,121 @@
+package chat
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const inlineText = `<b>bold</b>, <i>it</i>, <u>u</u>, <s>s</s>, <code class="inline-code">a_b</code>, 1 &lt; 2 and <a href="https://example.com/a b">a link</a>`
+
+func document(blocks ...string) string {
+	return `{"blocks": [` + strings.Join(blocks, ",") + `]}`
+}
+
+func paragraph(text string) string {
+	return `{"type": "paragraph", "data": {"text": "` + text + `"}}`
+}
+
+func TestInline(t *testing.T) {
+	assert.Equal(t, "*bold*, _it_, u, ~s~, `a_b`, 1 &lt; 2 and <https://example.com/a%20b|a link>", New(Slack).Inline(inlineText))
+	assert.Equal(t, `<b>bold</b>, <i>it</i>, <u>u</u>, <s>s</s>, <code>a_b</code>, 1 &lt; 2 and <a href="https://example.com/a b">a link</a>`, New(Telegram).Inline(inlineText))
+	assert.Equal(t, "**bold**, *it*, __u__, ~~s~~, `a_b`, 1 \\< 2 and [a link](https://example.com/a%20b)", New(Discord).Inline(inlineText))
+}
+
+func TestDiscordLineStarts(t *testing.T) {
+	out, err := New(Discord).Parse(document(paragraph(`# not a heading<br>- not a list`)), support.Limits{})
+	assert.NoError(t, err)
+	assert.Equal(t, "\\# not a heading\n\\- not a list", out)
+}
+
+func TestNestedList(t *testing.T) {
+	list := `{"type": "list", "data": {"style": "unordered", "items": [{"content": "one", "items": [{"content": "two", "items": []}]}, {"content": "three", "items": []}]}}`
+
+	slack, _ := New(Slack).Parse(document(list), support.Limits{})
+	assert.Equal(t, "• one\n    ◦ two\n• three", slack)
+
+	discord, _ := New(Discord).Parse(document(list), support.Limits{})
+	assert.Equal(t, "- one\n  - two\n- three", discord)
+}
+
+func TestChecklist(t *testing.T) {
+	out, _ := New(Telegram).Parse(document(`{"type": "checklist", "data": {"items": [{"text": "done", "checked": true}, {"text": "<b>todo</b>", "checked": false}]}}`), support.Limits{})
+	assert.Equal(t, "☑ done\n☐ <b>todo</b>", out)
+}
+
+func TestCode(t *testing.T) {
+	code := `{"type": "code", "data": {"code": "if a < b {}", "languageCode": "go"}}`
+
+	slack, _ := New(Slack).Parse(document(code), support.Limits{})
+	assert.Equal(t, "```\nif a &lt; b {}\n```", slack)
+
+	telegram, _ := New(Telegram).Parse(document(code), support.Limits{})
+	assert.Equal(t, `<pre><code class="language-go">if a &lt; b {}</code></pre>`, telegram)
+
+	discord, _ := New(Discord).Parse(document(code), support.Limits{})
+	assert.Equal(t, "```go\nif a < b {}\n```", discord)
+}
+
+func TestTable(t *testing.T) {
+	out, _ := New(Discord).Parse(document(`{"type": "table", "data": {"withHeadings": true, "content": [["Name", "Value"], ["<b>a</b>"]]}}`), support.Limits{})
+	assert.Equal(t, "```\nName | Value\n-----+------\na    |\n```", out)
+}
+
+func TestMessagesPackBlocks(t *testing.T) {
+	var blocks []string
+	for i := 0; i < 10; i++ {
+		blocks = append(blocks, paragraph(strings.Repeat("x", 40)+strconv.Itoa(i)))
+	}
+
+	messages, err := Renderer{Platform: Discord, Limit: 100}.Messages(document(blocks...), support.Limits{})
+	assert.NoError(t, err)
+	assert.Len(t, messages, 5)
+
+	for _, message := range messages {
+		assert.LessOrEqual(t, length(message), 100)
+		assert.Len(t, strings.Split(message, "\n\n"), 2) // blocks are never cut when they fit
+	}
+}
+
+func TestMessagesSplitCode(t *testing.T) {
+	var lines []string
+	for i := 0; i < 30; i++ {
+		lines = append(lines, "line "+strconv.Itoa(i))
+	}
+
+	code := `{"type": "code", "data": {"code": "` + strings.Join(lines, `\n`) + `", "languageCode": "go"}}`
+
+	messages, err := Renderer{Platform: Discord, Limit: 60}.Messages(document(code), support.Limits{})
+	assert.NoError(t, err)
+	assert.Greater(t, len(messages), 1)
+
+	var joined []string
+	for _, message := range messages {
+		assert.LessOrEqual(t, length(message), 60)
+		assert.True(t, strings.HasPrefix(message, "```go\n"))
+		assert.True(t, strings.HasSuffix(message, "\n```"))
+		joined = append(joined, strings.TrimSuffix(strings.TrimPrefix(message, "```go\n"), "\n```"))
+	}
+
+	assert.Equal(t, strings.Join(lines, "\n"), strings.Join(joined, "\n"))
+}
+
+func TestMessagesSplitLongTelegramText(t *testing.T) {
+	text := strings.Repeat("<b>word</b> ", 30)
+
+	messages, err := Renderer{Platform: Telegram, Limit: 50}.Messages(document(paragraph(text)), support.Limits{})
+	assert.NoError(t, err)
+
+	for _, message := range messages {
+		assert.LessOrEqual(t, length(message), 50)
+		assert.NotContains(t, message, "<b") // markup is dropped rather than left unbalanced
+	}
+	assert.Equal(t, strings.TrimSpace(strings.Repeat("word ", 30)), strings.TrimSpace(strings.Join(messages, " ")))
+}
+
+func TestLength(t *testing.T) {
+	assert.Equal(t, 3, length("a😀"))
+	assert.Equal(t, []string{"ab", "😀", "c"}, cutLine("ab😀c", 2))
+}
@@ -0,0 +1,33 @@
+package chat
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"strings"
+)
+
+// Parse renders the document as one text, which may exceed the platform's
+// message length cap; Messages splits it.
+func (r Renderer) Parse(jsonstr string, limits support.Limits) (string, error) {
+	blocks, err := support.RenderBlocks(jsonstr, limits, r)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(blocks, "\n\n"), nil
+}
+
+// Messages renders the document as messages that each fit the platform's
+// message length cap, splitting between blocks where possible.
+func (r Renderer) Messages(jsonstr string, limits support.Limits) ([]string, error) {
+	blocks, err := support.RenderBlocks(jsonstr, limits, r)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := r.Limit
+	if limit <= 0 {
+		limit = r.Platform.dialect().limit
+	}
+
+	return split(blocks, limit, r.Platform.dialect()), nil
+}
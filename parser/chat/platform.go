@@ -0,0 +1,206 @@
+package chat
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"html"
+	"regexp"
+	"strings"
+)
+
+type Platform string
+
+const (
+	Slack    Platform = "slack"
+	Telegram Platform = "telegram"
+	Discord  Platform = "discord"
+)
+
+// Message length caps, counted in UTF-16 code units like Telegram does; the
+// count is never lower than the characters Slack and Discord count.
+const (
+	// SlackLimit is the text length Slack recommends for chat.postMessage.
+	SlackLimit    = 4000
+	TelegramLimit = 4096
+	DiscordLimit  = 2000
+)
+
+type dialect struct {
+	markup inline.Markup
+	limit  int
+	// escape protects plain text outside inline markup, such as file names.
+	escape func(text string) string
+	bold   func(text string) string
+	link   func(text, href string) string
+	header func(level int, text string) string
+	quote  func(text string) string
+	// list markers for unordered items, by nesting depth; ordered lists
+	// use numbers unless the platform renders Markdown lists.
+	bullets       []string
+	markdownLists bool
+	codeBlock     func(code, language string) string
+	// codeOpen matches the start of a code block, so that a block split
+	// across messages can be closed and reopened.
+	codeOpen *regexp.Regexp
+	// lineStart matches the line starts that need escaping in text.
+	lineStart *regexp.Regexp
+	// plain strips the markup from a line that must be cut, for platforms
+	// that reject unbalanced markup.
+	plain func(line string) string
+}
+
+var slackEscaper = strings.NewReplacer(`&`, `&amp;`, `<`, `&lt;`, `>`, `&gt;`)
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`*`, `\*`,
+	`_`, `\_`,
+	`~`, `\~`,
+	"`", "\\`",
+	`|`, `\|`,
+	`<`, `\<`,
+	`[`, `\[`,
+	`]`, `\]`,
+)
+
+// markdownLineStart matches the line starts Discord reads as headings, quotes
+// or list items.
+var markdownLineStart = regexp.MustCompile(`^(#|>|-# |[-+] |\d+\. )`)
+
+var hrefEscaper = strings.NewReplacer(` `, `%20`, `|`, `%7C`, `<`, `%3C`, `>`, `%3E`, `(`, `%28`, `)`, `%29`)
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+func slackLink(text, href string) string {
+	return "<" + hrefEscaper.Replace(href) + "|" + text + ">"
+}
+
+func discordLink(text, href string) string {
+	return "[" + text + "](" + hrefEscaper.Replace(href) + ")"
+}
+
+func telegramLink(text, href string) string {
+	return `<a href="` + html.EscapeString(href) + `">` + text + `</a>`
+}
+
+// fence keeps the closing fence on its own line and breaks up fences inside
+// the code, which Slack and Discord can't escape.
+func fence(code, language string) string {
+	return "```" + language + "\n" + strings.ReplaceAll(code, "```", "`\u200b``") + "\n```"
+}
+
+func prefixLines(text, prefix string) string {
+	return prefix + strings.ReplaceAll(text, "\n", "\n"+prefix)
+}
+
+var dialects = map[Platform]dialect{
+	Slack: {
+		markup: inline.Markup{
+			Escape: slackEscaper.Replace,
+			Delimiters: map[inline.Kind][2]string{
+				inline.Bold:   {"*", "*"},
+				inline.Italic: {"_", "_"},
+				inline.Strike: {"~", "~"},
+			},
+			Code:  func(text string) string { return "`" + slackEscaper.Replace(strings.ReplaceAll(text, "`", "ˋ")) + "`" },
+			Link:  slackLink,
+			Break: "\n",
+		},
+		limit:   SlackLimit,
+		escape:  slackEscaper.Replace,
+		bold:    func(text string) string { return inline.Wrap(text, "*", "*") },
+		link:    slackLink,
+		header:  func(level int, text string) string { return inline.Wrap(text, "*", "*") },
+		quote:   func(text string) string { return prefixLines(text, "> ") },
+		bullets: []string{"•", "◦", "▪"},
+		codeBlock: func(code, language string) string {
+			return fence(slackEscaper.Replace(code), "")
+		},
+		codeOpen: regexp.MustCompile("^```\n"),
+	},
+	Telegram: {
+		markup: inline.Markup{
+			Escape: html.EscapeString,
+			Delimiters: map[inline.Kind][2]string{
+				inline.Bold:      {"<b>", "</b>"},
+				inline.Italic:    {"<i>", "</i>"},
+				inline.Underline: {"<u>", "</u>"},
+				inline.Strike:    {"<s>", "</s>"},
+			},
+			Code:  func(text string) string { return "<code>" + html.EscapeString(text) + "</code>" },
+			Link:  telegramLink,
+			Break: "\n",
+		},
+		limit:   TelegramLimit,
+		escape:  html.EscapeString,
+		bold:    func(text string) string { return "<b>" + text + "</b>" },
+		link:    telegramLink,
+		header:  func(level int, text string) string { return "<b>" + text + "</b>" },
+		quote:   func(text string) string { return "<blockquote>" + text + "</blockquote>" },
+		bullets: []string{"•", "◦", "▪"},
+		codeBlock: func(code, language string) string {
+			if language == "" {
+				return "<pre>" + html.EscapeString(code) + "</pre>"
+			}
+			return `<pre><code class="language-` + html.EscapeString(language) + `">` + html.EscapeString(code) + "</code></pre>"
+		},
+		codeOpen: regexp.MustCompile(`^<pre>(<code class="[^"]*">)?`),
+		plain: func(line string) string {
+			return html.EscapeString(html.UnescapeString(tagPattern.ReplaceAllString(line, "")))
+		},
+	},
+	Discord: {
+		markup: inline.Markup{
+			Escape: markdownEscaper.Replace,
+			Delimiters: map[inline.Kind][2]string{
+				inline.Bold:      {"**", "**"},
+				inline.Italic:    {"*", "*"},
+				inline.Underline: {"__", "__"},
+				inline.Strike:    {"~~", "~~"},
+			},
+			Code:  func(text string) string { return "`" + strings.ReplaceAll(text, "`", "ˋ") + "`" },
+			Link:  discordLink,
+			Break: "\n",
+		},
+		limit:  DiscordLimit,
+		escape: markdownEscaper.Replace,
+		bold:   func(text string) string { return inline.Wrap(text, "**", "**") },
+		link:   discordLink,
+		header: func(level int, text string) string {
+			// Discord only has three heading levels.
+			if level > 3 {
+				return inline.Wrap(text, "**", "**")
+			}
+			return strings.Repeat("#", level) + " " + text
+		},
+		quote:         func(text string) string { return prefixLines(text, "> ") },
+		bullets:       []string{"-"},
+		markdownLists: true,
+		codeBlock:     fence,
+		codeOpen:      regexp.MustCompile("^```[\\w+#-]*\n"),
+		lineStart:     markdownLineStart,
+	},
+}
+
+func Platforms() []Platform {
+	return []Platform{Slack, Telegram, Discord}
+}
+
+func (p Platform) dialect() dialect {
+	if d, ok := dialects[p]; ok {
+		return d
+	}
+	return dialects[Slack]
+}
+
+// codeClose returns the end of the code block opened by open.
+func codeClose(open string) string {
+	switch {
+	case strings.HasPrefix(open, "```"):
+		return "\n```"
+	case strings.Contains(open, "<code"):
+		return "</code></pre>"
+	case strings.HasPrefix(open, "<pre>"):
+		return "</pre>"
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+package chat
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// split packs the blocks into messages of at most limit, cutting blocks that
+// don't fit in a message of their own.
+func split(blocks []string, limit int, d dialect) []string {
+	var messages []string
+	current := ""
+
+	for _, block := range blocks {
+		for _, piece := range pieces(block, limit, d) {
+			switch {
+			case current == "":
+				current = piece
+			case length(current)+2+length(piece) <= limit:
+				current += "\n\n" + piece
+			default:
+				messages = append(messages, current)
+				current = piece
+			}
+		}
+	}
+
+	if current != "" {
+		messages = append(messages, current)
+	}
+
+	return messages
+}
+
+// pieces cuts a block longer than limit between lines. Code blocks are
+// closed and reopened around each piece; other blocks lose their markup on
+// platforms that reject unbalanced markup.
+func pieces(block string, limit int, d dialect) []string {
+	if length(block) <= limit {
+		return []string{block}
+	}
+
+	if open := d.codeOpen.FindString(block); open != "" {
+		close := codeClose(open)
+		if strings.HasSuffix(block, close) && length(open)+length(close) < limit {
+			chunks := cutLines(block[len(open):len(block)-len(close)], limit-length(open)-length(close))
+			for i, chunk := range chunks {
+				chunks[i] = open + chunk + close
+			}
+			return chunks
+		}
+	}
+
+	if d.plain != nil {
+		block = d.plain(block)
+	}
+
+	return cutLines(block, limit)
+}
+
+// cutLines packs the lines of text into chunks of at most limit, cutting
+// longer lines at spaces where possible.
+func cutLines(text string, limit int) []string {
+	var chunks []string
+	current, started := "", false
+
+	for _, line := range strings.Split(text, "\n") {
+		for _, part := range cutLine(line, limit) {
+			switch {
+			case !started:
+				current, started = part, true
+			case length(current)+1+length(part) <= limit:
+				current += "\n" + part
+			default:
+				chunks = append(chunks, current)
+				current = part
+			}
+		}
+	}
+
+	if started {
+		chunks = append(chunks, current)
+	}
+
+	return chunks
+}
+
+func cutLine(line string, limit int) []string {
+	var parts []string
+
+	for length(line) > limit {
+		end := offset(line, limit)
+
+		if space := strings.LastIndex(line[:end], " "); space > 0 {
+			end = space
+		} else if amp := strings.LastIndex(line[:end], "&"); amp > 0 && !strings.Contains(line[amp:end], ";") {
+			// don't cut inside an HTML entity
+			end = amp
+		}
+
+		if end == 0 {
+			_, end = utf8.DecodeRuneInString(line)
+		}
+
+		parts = append(parts, line[:end])
+		line = strings.TrimPrefix(line[end:], " ")
+	}
+
+	return append(parts, line)
+}
+
+// length counts UTF-16 code units, the unit of Telegram's limit, which is
+// never less than the characters Slack and Discord count.
+func length(s string) int {
+	n := 0
+	for _, r := range s {
+		n++
+		if r > 0xFFFF {
+			n++
+		}
+	}
+	return n
+}
+
+// offset returns the byte offset of the longest prefix of s within limit.
+func offset(s string, limit int) int {
+	n := 0
+	for i, r := range s {
+		size := 1
+		if r > 0xFFFF {
+			size = 2
+		}
+		if n+size > limit {
+			return i
+		}
+		n += size
+	}
+	return len(s)
+}
@@ -0,0 +1,587 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/parser/html"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// maxImageWidth is the text width of a Letter/A4 page with 1" margins in EMU.
+const (
+	emuPerPixel   = 9525
+	maxImageWidth = 5943600
+)
+
+type Renderer struct {
+	// Fetcher resolves the images of image and imageGallery blocks so they
+	// can be embedded; images it doesn't fetch become hyperlinks.
+	Fetcher html.Fetcher
+	Limits  support.Limits
+}
+
+func New() Renderer {
+	return Renderer{}
+}
+
+type media struct {
+	name    string
+	content []byte
+}
+
+type document struct {
+	Renderer
+	body      []string
+	relations []string
+	media     []media
+	nums      []string
+	ids       int
+	err       error
+}
+
+// run holds the character formatting of a run; properties writes it in the
+// order the schema requires, so nested formatting can't repeat or reorder it.
+type run struct {
+	style     string
+	bold      bool
+	italic    bool
+	strike    bool
+	highlight bool
+	underline bool
+}
+
+func (r Renderer) Render(jsonStr string, w io.Writer) error {
+	editorJSON, err := support.ParseEditorJSONWithLimits(jsonStr, r.Limits)
+	if err != nil {
+		return err
+	}
+
+	d := &document{Renderer: r}
+
+	for _, el := range editorJSON.Blocks {
+		if err = support.CheckBlockLimits(el, r.Limits); err != nil {
+			return err
+		}
+
+		if _, _, err = support.RenderBlock(el.Type, support.PrepareData(el), blocks{d}); err != nil {
+			return err
+		}
+
+		if d.err != nil {
+			return d.err
+		}
+	}
+
+	return d.write(w)
+}
+
+// blocks writes each block into the document; support.RenderBlock picks the
+// method for the block type. Image errors are kept in the document's err.
+type blocks struct {
+	d *document
+}
+
+func (b blocks) Header(el *domain.EditorJSDataHeader) string {
+	level := el.Level
+	if level < 1 || level > 6 {
+		level = 2
+	}
+	b.d.paragraph("Heading"+strconv.Itoa(level), "", b.d.runs(inline.Parse(el.Text), run{}))
+	return ""
+}
+
+func (b blocks) Paragraph(el *domain.EditorJSDataParagraph) string {
+	b.d.paragraph("", alignment(el.Alignment), b.d.runs(inline.Parse(el.Text), run{}))
+	return ""
+}
+
+func (b blocks) Quote(el *domain.EditorJSDataQuote) string {
+	b.d.paragraph("Quote", alignment(el.Alignment), b.d.runs(inline.Parse(el.Text), run{}))
+	if el.Caption != "" {
+		b.d.paragraph("Quote", alignment(el.Alignment), textRun("— ", run{})+b.d.runs(inline.Parse(el.Caption), run{}))
+	}
+	return ""
+}
+
+func (b blocks) Warning(el *domain.EditorJSDataWarning) string {
+	b.d.paragraph("Callout", "", b.d.runs(inline.Parse(el.Title), run{bold: true})+breakRun()+b.d.runs(inline.Parse(el.Message), run{}))
+	return ""
+}
+
+func (b blocks) Delimiter() string {
+	b.d.paragraph("", "center", textRun("* * *", run{}))
+	return ""
+}
+
+func (b blocks) Alert(el *domain.EditorJSDataAlert) string {
+	b.d.paragraph("Callout", "", b.d.runs(inline.Parse(el.Message), run{}))
+	return ""
+}
+
+func (b blocks) List(el *domain.EditorJSDataList) (string, error) {
+	return "", b.d.list(el)
+}
+
+func (b blocks) Checklist(el *domain.EditorJSDataChecklist) string {
+	for _, item := range el.Items {
+		box := "☐ "
+		if item.Checked {
+			box = "☒ "
+		}
+		b.d.paragraph("ListParagraph", "", textRun(box, run{})+b.d.runs(inline.Parse(item.Text), run{}))
+	}
+	return ""
+}
+
+func (b blocks) Table(el *domain.EditorJSDataTable) string {
+	b.d.table(el)
+	return ""
+}
+
+func (b blocks) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	b.d.paragraph("", "", b.d.hyperlink(el.Link, b.d.runs(inline.Parse(el.Text), run{style: "Hyperlink"})))
+	return ""
+}
+
+func (b blocks) Code(el *domain.EditorJSDataCode) string {
+	b.d.code(el.Code)
+	return ""
+}
+
+func (b blocks) Raw(el *domain.EditorJSDataRaw) string {
+	b.d.code(el.Html)
+	return ""
+}
+
+func (b blocks) Image(el *domain.EditorJSDataImage) string {
+	url := el.File.URL
+	if url == "" {
+		url = el.URL
+	}
+	if b.d.err = b.d.image(url, inline.PlainText(inline.Parse(el.Caption))); b.d.err != nil {
+		return ""
+	}
+	if el.Caption != "" {
+		b.d.paragraph("Caption", "", b.d.runs(inline.Parse(el.Caption), run{}))
+	}
+	return ""
+}
+
+func (b blocks) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	title := el.Meta.Title
+	if title == "" {
+		title = el.Link
+	}
+	b.d.paragraph("", "", b.d.hyperlink(el.Link, b.d.runs(inline.Parse(title), run{style: "Hyperlink", bold: true})))
+	if el.Meta.Description != "" {
+		b.d.paragraph("", "", b.d.runs(inline.Parse(el.Meta.Description), run{}))
+	}
+	return ""
+}
+
+func (b blocks) Attaches(el *domain.EditorJSDataAttaches) string {
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+	b.d.paragraph("", "", b.d.hyperlink(el.File.URL, textRun(name, run{style: "Hyperlink"}))+textRun(" ("+support.HumanFileSize(el.File.Size)+")", run{}))
+	return ""
+}
+
+func (b blocks) Embed(el *domain.EditorJSDataEmbed) string {
+	if el.Caption != "" {
+		b.d.paragraph("", "", b.d.runs(inline.Parse(el.Caption), run{}))
+	}
+	b.d.paragraph("", "", b.d.hyperlink(el.Source, textRun("Watch on "+el.Service, run{style: "Hyperlink"})))
+	return ""
+}
+
+func (b blocks) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	for _, url := range el.URLs {
+		if b.d.err = b.d.image(url, ""); b.d.err != nil {
+			return ""
+		}
+	}
+	return ""
+}
+
+func (d *document) paragraph(style, align, runs string) {
+	props := ""
+	if style != "" {
+		props += `<w:pStyle w:val="` + style + `"/>`
+	}
+	if align != "" {
+		props += `<w:jc w:val="` + align + `"/>`
+	}
+	if props != "" {
+		props = `<w:pPr>` + props + `</w:pPr>`
+	}
+
+	d.body = append(d.body, `<w:p>`+props+runs+`</w:p>`)
+}
+
+func (d *document) runs(nodes []inline.Node, r run) string {
+	var result strings.Builder
+
+	for _, node := range nodes {
+		switch node.Kind {
+		case inline.Text:
+			result.WriteString(textRun(node.Text, r))
+		case inline.Break:
+			result.WriteString(breakRun())
+		case inline.Code:
+			code := r
+			code.style = "CodeChar"
+			result.WriteString(textRun(inline.PlainText(node.Children), code))
+		case inline.Bold:
+			bold := r
+			bold.bold = true
+			result.WriteString(d.runs(node.Children, bold))
+		case inline.Italic:
+			italic := r
+			italic.italic = true
+			result.WriteString(d.runs(node.Children, italic))
+		case inline.Underline:
+			underline := r
+			underline.underline = true
+			result.WriteString(d.runs(node.Children, underline))
+		case inline.Strike:
+			strike := r
+			strike.strike = true
+			result.WriteString(d.runs(node.Children, strike))
+		case inline.Mark:
+			mark := r
+			mark.highlight = true
+			result.WriteString(d.runs(node.Children, mark))
+		case inline.Link:
+			link := r
+			if link.style == "" {
+				link.style = "Hyperlink"
+			}
+			result.WriteString(d.hyperlink(node.Href, d.runs(node.Children, link)))
+		}
+	}
+
+	return result.String()
+}
+
+func textRun(text string, r run) string {
+	return `<w:r>` + r.properties() + `<w:t xml:space="preserve">` + escape(text) + `</w:t></w:r>`
+}
+
+func (r run) properties() string {
+	props := ""
+	if r.style != "" {
+		props += `<w:rStyle w:val="` + r.style + `"/>`
+	}
+	if r.bold {
+		props += `<w:b/>`
+	}
+	if r.italic {
+		props += `<w:i/>`
+	}
+	if r.strike {
+		props += `<w:strike/>`
+	}
+	if r.highlight {
+		props += `<w:highlight w:val="yellow"/>`
+	}
+	if r.underline {
+		props += `<w:u w:val="single"/>`
+	}
+
+	if props == "" {
+		return ""
+	}
+	return `<w:rPr>` + props + `</w:rPr>`
+}
+
+func breakRun() string {
+	return `<w:r><w:br/></w:r>`
+}
+
+func (d *document) relation(relType, target string, external bool) string {
+	d.ids++
+	id := "rId" + strconv.Itoa(d.ids+3)
+
+	mode := ""
+	if external {
+		mode = ` TargetMode="External"`
+	}
+
+	d.relations = append(d.relations, `<Relationship Id="`+id+`" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/`+relType+`" Target="`+escape(target)+`"`+mode+`/>`)
+
+	return id
+}
+
+func (d *document) hyperlink(href, runs string) string {
+	if href == "" {
+		return runs
+	}
+
+	return `<w:hyperlink r:id="` + d.relation("hyperlink", href, true) + `">` + runs + `</w:hyperlink>`
+}
+
+func alignment(align string) string {
+	switch align {
+	case "center":
+		return "center"
+	case "right":
+		return "right"
+	}
+	return ""
+}
+
+// list gives every list its own numbering instance so ordered lists restart
+// at 1; nested items use the deeper levels of the same instance.
+func (d *document) list(el *domain.EditorJSDataList) error {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return err
+	}
+
+	abstract := "0"
+	if el.Style == "ordered" {
+		abstract = "1"
+	}
+
+	numID := strconv.Itoa(len(d.nums) + 1)
+	d.nums = append(d.nums, `<w:num w:numId="`+numID+`"><w:abstractNumId w:val="`+abstract+`"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride></w:num>`)
+
+	d.listItems(itemsList, numID, 0)
+	return nil
+}
+
+func (d *document) listItems(items []domain.NestedListItem, numID string, level int) {
+	if level > 8 {
+		level = 8
+	}
+
+	for _, item := range items {
+		d.body = append(d.body, `<w:p><w:pPr><w:pStyle w:val="ListParagraph"/><w:numPr><w:ilvl w:val="`+strconv.Itoa(level)+`"/><w:numId w:val="`+numID+`"/></w:numPr></w:pPr>`+d.runs(inline.Parse(item.Content), run{})+`</w:p>`)
+		d.listItems(item.Items, numID, level+1)
+	}
+}
+
+func (d *document) table(el *domain.EditorJSDataTable) {
+	columns := 0
+	for _, row := range el.Content {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	if columns == 0 {
+		return
+	}
+
+	var output []string
+
+	output = append(output, `<w:tbl><w:tblPr><w:tblStyle w:val="TableGrid"/><w:tblW w:w="5000" w:type="pct"/></w:tblPr><w:tblGrid>`+strings.Repeat(`<w:gridCol/>`, columns)+`</w:tblGrid>`)
+
+	for index, row := range el.Content {
+		header := el.WithHeadings && index == 0
+
+		rowProps := ""
+		cellRun := run{}
+		if header {
+			rowProps = `<w:trPr><w:tblHeader/></w:trPr>`
+			cellRun.bold = true
+		}
+
+		output = append(output, `<w:tr>`+rowProps)
+
+		for column := 0; column < columns; column++ {
+			text := ""
+			if column < len(row) {
+				text = row[column]
+			}
+			output = append(output, `<w:tc><w:p>`+d.runs(inline.Parse(text), cellRun)+`</w:p></w:tc>`)
+		}
+
+		output = append(output, `</w:tr>`)
+	}
+
+	output = append(output, `</w:tbl>`)
+
+	d.body = append(d.body, strings.Join(output, ""))
+
+	// Word merges consecutive tables, so keep an empty paragraph after each.
+	d.paragraph("", "", "")
+}
+
+func (d *document) code(code string) {
+	var runs []string
+
+	for i, line := range strings.Split(strings.ReplaceAll(code, "\r\n", "\n"), "\n") {
+		if i > 0 {
+			runs = append(runs, breakRun())
+		}
+		runs = append(runs, textRun(line, run{}))
+	}
+
+	d.paragraph("Code", "", strings.Join(runs, ""))
+}
+
+func (d *document) image(url, description string) error {
+	if url == "" {
+		return nil
+	}
+
+	var content []byte
+	var err error
+
+	if d.Fetcher != nil {
+		content, err = d.Fetcher.Fetch(url)
+	}
+
+	if d.Fetcher == nil || err != nil {
+		if err != nil && !errors.Is(err, html.ErrNotFetched) {
+			return fmt.Errorf("editorjs: fetching %s: %w", url, err)
+		}
+
+		d.imageLink(url)
+		return nil
+	}
+
+	// formats Word can't show, such as WebP or SVG, and broken files are
+	// linked instead
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		d.imageLink(url)
+		return nil
+	}
+
+	ext := "." + format
+	if format == "jpeg" {
+		ext = ".jpg"
+	}
+
+	name := "image" + strconv.Itoa(len(d.media)+1) + ext
+	d.media = append(d.media, media{name: name, content: content})
+	id := d.relation("image", "media/"+name, false)
+
+	width := config.Width * emuPerPixel
+	height := config.Height * emuPerPixel
+	if width > maxImageWidth {
+		height = height * maxImageWidth / width
+		width = maxImageWidth
+	}
+
+	number := strconv.Itoa(len(d.media))
+	extent := `cx="` + strconv.Itoa(width) + `" cy="` + strconv.Itoa(height) + `"`
+
+	d.paragraph("", "center", `<w:r><w:drawing><wp:inline><wp:extent `+extent+`/><wp:docPr id="`+number+`" name="Picture `+number+`" descr="`+escape(description)+`"/>`+
+		`<a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"><a:graphicData uri="http://schemas.openxmlformats.org/drawingml/2006/picture">`+
+		`<pic:pic xmlns:pic="http://schemas.openxmlformats.org/drawingml/2006/picture"><pic:nvPicPr><pic:cNvPr id="`+number+`" name="`+name+`"/><pic:cNvPicPr/></pic:nvPicPr>`+
+		`<pic:blipFill><a:blip r:embed="`+id+`"/><a:stretch><a:fillRect/></a:stretch></pic:blipFill>`+
+		`<pic:spPr><a:xfrm><a:off x="0" y="0"/><a:ext `+extent+`/></a:xfrm><a:prstGeom prst="rect"><a:avLst/></a:prstGeom></pic:spPr></pic:pic>`+
+		`</a:graphicData></a:graphic></wp:inline></w:drawing></w:r>`)
+
+	return nil
+}
+
+func (d *document) imageLink(url string) {
+	d.paragraph("", "", d.hyperlink(url, textRun(url, run{style: "Hyperlink"})))
+}
+
+func (d *document) write(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	files := []media{
+		{"[Content_Types].xml", []byte(contentTypes)},
+		{"_rels/.rels", []byte(packageRelations)},
+		{"word/document.xml", []byte(xmlHeader + `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing"><w:body>` +
+			strings.Join(d.body, "\n") +
+			`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/><w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="720" w:footer="720" w:gutter="0"/></w:sectPr></w:body></w:document>`)},
+		{"word/_rels/document.xml.rels", []byte(xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering" Target="numbering.xml"/>` +
+			`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/settings" Target="settings.xml"/>` +
+			strings.Join(d.relations, "") + `</Relationships>`)},
+		{"word/styles.xml", []byte(styles)},
+		{"word/settings.xml", []byte(settings)},
+		{"word/numbering.xml", []byte(numbering(d.nums))},
+	}
+
+	for _, m := range d.media {
+		files = append(files, media{"word/media/" + m.name, m.content})
+	}
+
+	for _, file := range files {
+		fw, err := zw.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err = fw.Write(file.content); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+var xmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func escape(s string) string {
+	return xmlEscaper.Replace(s)
+}
+
+func numbering(nums []string) string {
+	bullets := []string{"•", "◦", "▪"}
+
+	var bullet, decimal []string
+	for level := 0; level < 9; level++ {
+		indent := strconv.Itoa(720 * (level + 1))
+		lvl := strconv.Itoa(level)
+
+		bullet = append(bullet, `<w:lvl w:ilvl="`+lvl+`"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="`+bullets[level%3]+`"/><w:lvlJc w:val="left"/><w:pPr><w:ind w:left="`+indent+`" w:hanging="360"/></w:pPr></w:lvl>`)
+		decimal = append(decimal, `<w:lvl w:ilvl="`+lvl+`"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%`+strconv.Itoa(level+1)+`."/><w:lvlJc w:val="left"/><w:pPr><w:ind w:left="`+indent+`" w:hanging="360"/></w:pPr></w:lvl>`)
+	}
+
+	return xmlHeader + `<w:numbering xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:abstractNum w:abstractNumId="0"><w:multiLevelType w:val="hybridMultilevel"/>` + strings.Join(bullet, "") + `</w:abstractNum>` +
+		`<w:abstractNum w:abstractNumId="1"><w:multiLevelType w:val="hybridMultilevel"/>` + strings.Join(decimal, "") + `</w:abstractNum>` +
+		strings.Join(nums, "") + `</w:numbering>`
+}
+
+func mediaExtensions() string {
+	var defaults []string
+	for _, ext := range []string{"png", "jpg", "jpeg", "gif"} {
+		contentType := "image/" + ext
+		if ext == "jpg" {
+			contentType = "image/jpeg"
+		}
+		defaults = append(defaults, `<Default Extension="`+ext+`" ContentType="`+contentType+`"/>`)
+	}
+	return strings.Join(defaults, "")
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+var contentTypes = xmlHeader + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	mediaExtensions() +
+	`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+	`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+	`<Override PartName="/word/settings.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.settings+xml"/>` +
+	`<Override PartName="/word/numbering.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>` +
+	`</Types>`
+
+const packageRelations = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+	`</Relationships>`
+
+const settings = xmlHeader + `<w:settings xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:defaultTabStop w:val="720"/></w:settings>`
@@ -0,0 +1,140 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"github.com/banjuanshu/go-editorjs/parser/html"
+	"github.com/matryer/is"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const allBlocks = `{
+    "blocks": [
+        {"type": "header", "data": {"text": "Contract &amp; terms", "level": 2}},
+        {"type": "paragraph", "data": {"text": "Text with <b>bold</b>, <i>italic</i>, <code>x < y</code> and a <a href=\"https://codex.so?a=1&amp;b=2\">link</a>.<br>Next line", "alignment": "right"}},
+        {"type": "quote", "data": {"text": "Quoted", "caption": "Author"}},
+        {"type": "warning", "data": {"title": "Note", "message": "Careful"}},
+        {"type": "delimiter", "data": {}},
+        {"type": "list", "data": {"style": "ordered", "items": [{"content": "One", "items": [{"content": "Nested", "items": []}]}, {"content": "Two", "items": []}]}},
+        {"type": "list", "data": {"style": "unordered", "items": ["Plain"]}},
+        {"type": "checklist", "data": {"items": [{"text": "Done", "checked": true}]}},
+        {"type": "table", "data": {"withHeadings": true, "content": [["Name", "Value"], ["A", "1"]]}},
+        {"type": "code", "data": {"code": "a := 1\nb := 2"}},
+        {"type": "image", "data": {"file": {"url": "img/dot.png"}, "caption": "Dot"}},
+        {"type": "image", "data": {"file": {"url": "https://example.com/remote.png"}}},
+        {"type": "linkTool", "data": {"link": "https://codex.so", "meta": {"title": "CodeX"}}},
+        {"type": "AnyButton", "data": {"link": "https://example.com/buy", "text": "Buy"}}
+    ]
+}`
+
+func readDocx(t *testing.T, content []byte) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(data)
+	}
+
+	return files
+}
+
+func TestRenderDocx(t *testing.T) {
+	is := is.New(t)
+
+	var img bytes.Buffer
+	is.NoErr(png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 10, 5))))
+
+	r := New()
+	r.Fetcher = html.LocalFetcher{FS: fstest.MapFS{"img/dot.png": &fstest.MapFile{Data: img.Bytes()}}}
+
+	var out bytes.Buffer
+	is.NoErr(r.Render(allBlocks, &out))
+
+	files := readDocx(t, out.Bytes())
+
+	for name, content := range files {
+		if strings.HasSuffix(name, ".xml") || strings.HasSuffix(name, ".rels") {
+			dec := xml.NewDecoder(strings.NewReader(content))
+			for {
+				_, err := dec.Token()
+				if err == io.EOF {
+					break
+				}
+				is.NoErr(err) // every part is well-formed XML
+			}
+		}
+	}
+
+	doc := files["word/document.xml"]
+	rels := files["word/_rels/document.xml.rels"]
+
+	is.True(strings.Contains(doc, `<w:pStyle w:val="Heading2"/>`))
+	is.True(strings.Contains(doc, `Contract &amp; terms`))
+	is.True(strings.Contains(doc, `<w:jc w:val="right"/>`))
+	is.True(strings.Contains(doc, `<w:rStyle w:val="CodeChar"/></w:rPr><w:t xml:space="preserve">x &lt; y</w:t>`))
+	is.True(strings.Contains(doc, `<w:ilvl w:val="1"/><w:numId w:val="1"/>`)) // nested items use deeper levels
+	is.True(strings.Contains(doc, `<w:numId w:val="2"/>`))                    // each list gets its own numbering
+	is.True(strings.Contains(doc, `<w:trPr><w:tblHeader/></w:trPr>`))
+	is.True(strings.Contains(doc, `<w:pStyle w:val="Code"/>`))
+	is.True(strings.Contains(doc, `<a:blip r:embed="`))
+	is.True(strings.Contains(doc, `cx="95250" cy="47625"`))
+
+	is.True(strings.Contains(rels, `Target="https://codex.so?a=1&amp;b=2" TargetMode="External"`))
+	is.True(strings.Contains(rels, `Target="https://example.com/buy" TargetMode="External"`))
+	is.True(strings.Contains(rels, `Target="https://example.com/remote.png" TargetMode="External"`)) // remote images become links
+	is.True(strings.Contains(rels, `Target="media/image1.png"`))
+
+	is.True(strings.Contains(files["word/numbering.xml"], `<w:abstractNumId w:val="1"/>`))
+	is.True(files["word/media/image1.png"] != "")
+	is.True(files["word/styles.xml"] != "")
+}
+
+func TestUndecodableImagesBecomeLinks(t *testing.T) {
+	is := is.New(t)
+
+	r := New()
+	r.Fetcher = html.LocalFetcher{FS: fstest.MapFS{
+		"img/logo.svg":   &fstest.MapFile{Data: []byte(`<svg xmlns="http://www.w3.org/2000/svg"/>`)},
+		"img/broken.png": &fstest.MapFile{Data: []byte("not a png")},
+	}}
+
+	var out bytes.Buffer
+	is.NoErr(r.Render(`{"blocks": [
+		{"type": "image", "data": {"file": {"url": "img/logo.svg"}}},
+		{"type": "imageGallery", "data": {"urls": ["img/broken.png"]}}
+	]}`, &out))
+
+	files := readDocx(t, out.Bytes())
+	is.True(strings.Contains(files["word/_rels/document.xml.rels"], `Target="img/logo.svg" TargetMode="External"`))
+	is.True(strings.Contains(files["word/_rels/document.xml.rels"], `Target="img/broken.png" TargetMode="External"`))
+	is.Equal(files["word/media/image1.png"], "") // nothing is embedded
+}
+
+func TestRunProperties(t *testing.T) {
+	is := is.New(t)
+
+	var out bytes.Buffer
+	is.NoErr(New().Render(`{"blocks": [
+		{"type": "paragraph", "data": {"text": "<u><b><b>x</b></b></u> <a href=\"https://example.com\"><mark><i>y</i></mark></a>"}}
+	]}`, &out))
+
+	doc := readDocx(t, out.Bytes())["word/document.xml"]
+
+	is.True(strings.Contains(doc, `<w:rPr><w:b/><w:u w:val="single"/></w:rPr><w:t xml:space="preserve">x</w:t>`))                                      // properties appear once, in schema order
+	is.True(strings.Contains(doc, `<w:rPr><w:rStyle w:val="Hyperlink"/><w:i/><w:highlight w:val="yellow"/></w:rPr><w:t xml:space="preserve">y</w:t>`)) // the style comes first
+}
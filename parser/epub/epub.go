@@ -0,0 +1,363 @@
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	html "github.com/banjuanshu/go-editorjs/parser/html"
+	"github.com/banjuanshu/go-editorjs/parser/html/semantic"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Chapter struct {
+	Title string
+	JSON  string
+}
+
+type Book struct {
+	Title      string
+	Author     string
+	Language   string
+	Identifier string
+	Modified   time.Time
+	Chapters   []Chapter
+	// Fetcher resolves the images of image and imageGallery blocks so they
+	// can be packaged; images it doesn't fetch stay remote.
+	Fetcher html.Fetcher
+	Limits  support.Limits
+}
+
+type navItem struct {
+	title    string
+	href     string
+	level    int
+	children []*navItem
+}
+
+type chapterFile struct {
+	name   string
+	title  string
+	body   string
+	remote bool
+}
+
+// remoteSrcPattern finds resources a chapter loads from the network, which
+// EPUB 3 requires to be declared with the remote-resources property.
+var remoteSrcPattern = regexp.MustCompile(`\s(?:src|poster|data)="(?:[a-zA-Z][a-zA-Z0-9+.-]*:)?//`)
+
+func New(title, jsonStr string) Book {
+	return Book{Title: title, Chapters: []Chapter{{Title: title, JSON: jsonStr}}}
+}
+
+func (b Book) Write(w io.Writer) error {
+	if len(b.Chapters) == 0 {
+		return fmt.Errorf("editorjs: epub has no chapters")
+	}
+
+	language := b.Language
+	if language == "" {
+		language = "en"
+	}
+
+	assets := html.NewAssetCollector(b.Fetcher)
+	assets.SkipAttachments = true
+
+	var chapters []chapterFile
+	var nav []*navItem
+	modified := b.Modified
+
+	for i, chapter := range b.Chapters {
+		editorJSON, err := support.ParseEditorJSONWithLimits(chapter.JSON, b.Limits)
+		if err != nil {
+			return err
+		}
+
+		if modified.IsZero() && editorJSON.Time > 0 {
+			modified = time.UnixMilli(editorJSON.Time)
+		}
+
+		if err = assets.Collect(&editorJSON); err != nil {
+			return err
+		}
+
+		name := "chapter-" + strconv.Itoa(i+1) + ".xhtml"
+		title := chapter.Title
+		headers := anchorHeaders(&editorJSON, i+1)
+
+		if title == "" && len(headers) > 0 {
+			title = headers[0].title
+		}
+		if title == "" {
+			title = "Chapter " + strconv.Itoa(i+1)
+		}
+
+		rewritten, err := json.Marshal(editorJSON)
+		if err != nil {
+			return err
+		}
+
+		body, err := html.ParserWithLimits(string(rewritten), semantic.StyleName, b.Limits)
+		if err != nil {
+			return err
+		}
+
+		if body, err = toXHTML(body); err != nil {
+			return err
+		}
+
+		item := &navItem{title: title, href: name}
+		for _, header := range headers {
+			header.href = name + header.href
+		}
+		item.children = nestHeaders(headers)
+
+		nav = append(nav, item)
+		chapters = append(chapters, chapterFile{name: name, title: title, body: body, remote: remoteSrcPattern.MatchString(body)})
+	}
+
+	if modified.IsZero() {
+		modified = time.Now()
+	}
+
+	identifier := b.Identifier
+	if identifier == "" {
+		identifier = b.identifier()
+	}
+
+	zw := zip.NewWriter(w)
+
+	// The mimetype entry has to come first and be stored uncompressed.
+	mw, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	if _, err = io.WriteString(mw, "application/epub+zip"); err != nil {
+		return err
+	}
+
+	files := []html.BundleFile{
+		{Name: "META-INF/container.xml", Content: []byte(containerXML)},
+		{Name: "OEBPS/content.opf", Content: []byte(b.packageDocument(identifier, language, modified, chapters, assets.Files))},
+		{Name: "OEBPS/nav.xhtml", Content: []byte(navDocument(b.Title, language, nav))},
+	}
+
+	for _, chapter := range chapters {
+		files = append(files, html.BundleFile{Name: "OEBPS/" + chapter.name, Content: []byte(xhtmlDocument(chapter.title, language, chapter.body))})
+	}
+
+	for _, file := range assets.Files {
+		files = append(files, html.BundleFile{Name: "OEBPS/" + file.Name, Content: file.Content})
+	}
+
+	for _, file := range files {
+		fw, err := zw.Create(file.Name)
+		if err != nil {
+			return err
+		}
+		if _, err = fw.Write(file.Content); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// anchorHeaders gives every header block an anchor so the navigation
+// document can link to it, and returns them in document order.
+func anchorHeaders(editorJSON *domain.EditorJS, chapter int) (headers []*navItem) {
+	for i, el := range editorJSON.Blocks {
+		data, _ := el.Data.(map[string]interface{})
+		if el.Type != "header" || data == nil {
+			continue
+		}
+
+		anchor, _ := data["anchor"].(string)
+		if anchor == "" {
+			anchor = "c" + strconv.Itoa(chapter) + "-h" + strconv.Itoa(i+1)
+			data["anchor"] = anchor
+		}
+
+		level, _ := data["level"].(float64)
+		text, _ := data["text"].(string)
+
+		headers = append(headers, &navItem{
+			title: inline.PlainText(inline.Parse(text)),
+			href:  "#" + strings.ToLower(strings.ReplaceAll(anchor, " ", "-")),
+			level: int(level),
+		})
+	}
+
+	return
+}
+
+func nestHeaders(headers []*navItem) (root []*navItem) {
+	var stack []*navItem
+
+	for _, header := range headers {
+		for len(stack) > 0 && stack[len(stack)-1].level >= header.level {
+			stack = stack[:len(stack)-1]
+		}
+
+		if len(stack) == 0 {
+			root = append(root, header)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, header)
+		}
+
+		stack = append(stack, header)
+	}
+
+	return
+}
+
+// toXHTML reserializes the HTML fragment so void elements are closed and the
+// output is well-formed XML.
+func toXHTML(fragment string) (string, error) {
+	nodes, err := xhtml.ParseFragment(strings.NewReader(fragment), &xhtml.Node{Type: xhtml.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	for _, node := range nodes {
+		if err = xhtml.Render(&out, node); err != nil {
+			return "", err
+		}
+	}
+
+	return out.String(), nil
+}
+
+func (b Book) identifier() string {
+	h := sha1.New()
+	io.WriteString(h, b.Title)
+	for _, chapter := range b.Chapters {
+		io.WriteString(h, chapter.JSON)
+	}
+	sum := h.Sum(nil)
+
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+func (b Book) packageDocument(identifier, language string, modified time.Time, chapters []chapterFile, assets []html.BundleFile) string {
+	var manifest, spine []string
+
+	manifest = append(manifest, `<item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>`)
+
+	for i, chapter := range chapters {
+		id := "chapter-" + strconv.Itoa(i+1)
+		properties := ""
+		if chapter.remote {
+			properties = ` properties="remote-resources"`
+		}
+		manifest = append(manifest, `<item id="`+id+`" href="`+chapter.name+`" media-type="application/xhtml+xml"`+properties+`/>`)
+		spine = append(spine, `<itemref idref="`+id+`"/>`)
+	}
+
+	for i, asset := range assets {
+		manifest = append(manifest, `<item id="asset-`+strconv.Itoa(i+1)+`" href="`+escape(asset.Name)+`" media-type="`+mediaType(asset)+`"/>`)
+	}
+
+	var metadata []string
+	metadata = append(metadata, `<dc:identifier id="book-id">`+escape(identifier)+`</dc:identifier>`,
+		`<dc:title>`+escape(b.Title)+`</dc:title>`,
+		`<dc:language>`+escape(language)+`</dc:language>`)
+
+	if b.Author != "" {
+		metadata = append(metadata, `<dc:creator>`+escape(b.Author)+`</dc:creator>`)
+	}
+
+	metadata = append(metadata, `<meta property="dcterms:modified">`+modified.UTC().Format("2006-01-02T15:04:05Z")+`</meta>`)
+
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id" xml:lang="` + escape(language) + `">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    ` + strings.Join(metadata, "\n    ") + `
+  </metadata>
+  <manifest>
+    ` + strings.Join(manifest, "\n    ") + `
+  </manifest>
+  <spine>
+    ` + strings.Join(spine, "\n    ") + `
+  </spine>
+</package>
+`
+}
+
+func navDocument(title, language string, items []*navItem) string {
+	return xhtmlDocument(title, language, `<nav epub:type="toc" id="toc">
+<h1>`+escape(title)+`</h1>
+`+navList(items)+`
+</nav>`)
+}
+
+func navList(items []*navItem) string {
+	var output []string
+
+	output = append(output, `<ol>`)
+
+	for _, item := range items {
+		link := `<a href="` + escape(item.href) + `">` + escape(item.title) + `</a>`
+
+		if len(item.children) == 0 {
+			output = append(output, `<li>`+link+`</li>`)
+			continue
+		}
+
+		output = append(output, `<li>`+link, navList(item.children), `</li>`)
+	}
+
+	output = append(output, `</ol>`)
+
+	return strings.Join(output, "\n")
+}
+
+func xhtmlDocument(title, language, body string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="` + escape(language) + `" lang="` + escape(language) + `">
+<head>
+<meta charset="UTF-8"/>
+<title>` + escape(title) + `</title>
+</head>
+<body>
+` + body + `
+</body>
+</html>
+`
+}
+
+func mediaType(file html.BundleFile) string {
+	if t := mime.TypeByExtension(strings.ToLower(path.Ext(file.Name))); t != "" {
+		return strings.SplitN(t, ";", 2)[0]
+	}
+
+	return strings.SplitN(http.DetectContentType(file.Content), ";", 2)[0]
+}
+
+func escape(s string) string {
+	return xhtml.EscapeString(s)
+}
+
+const containerXML = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
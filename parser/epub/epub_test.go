@@ -0,0 +1,118 @@
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"github.com/banjuanshu/go-editorjs/parser/html"
+	"github.com/matryer/is"
+	"io"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const chapterOne = `{
+    "time": 1700000000000,
+    "blocks": [
+        {"type": "header", "data": {"text": "Getting <b>started</b>", "level": 1}},
+        {"type": "paragraph", "data": {"text": "Line one<br>line two &nbsp;done"}},
+        {"type": "header", "data": {"text": "Install", "level": 2}},
+        {"type": "image", "data": {"file": {"url": "img/diagram.png"}, "caption": "Diagram"}},
+        {"type": "header", "data": {"text": "Next", "level": 1}}
+    ]
+}`
+
+const chapterTwo = `{
+    "blocks": [
+        {"type": "header", "data": {"text": "Reference", "level": 2, "anchor": "Ref Top"}},
+        {"type": "imageGallery", "data": {"layoutDefault": true, "urls": ["img/diagram.png", "https://example.com/remote.jpg"]}},
+        {"type": "checklist", "data": {"items": [{"text": "Read", "checked": true}]}}
+    ]
+}`
+
+func readEPUB(t *testing.T, content []byte) (names []string, files map[string]string) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files = map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+
+		names = append(names, f.Name)
+		files[f.Name] = string(data)
+
+		if f.Name == "mimetype" && f.Method != zip.Store {
+			t.Fatal("mimetype must be stored uncompressed")
+		}
+	}
+
+	return
+}
+
+func TestWriteBook(t *testing.T) {
+	is := is.New(t)
+
+	book := Book{
+		Title:    "Handbook",
+		Author:   "Docs Team",
+		Chapters: []Chapter{{JSON: chapterOne}, {Title: "Appendix", JSON: chapterTwo}},
+		Fetcher:  html.LocalFetcher{FS: fstest.MapFS{"img/diagram.png": &fstest.MapFile{Data: []byte("\x89PNG\r\n\x1a\n")}}},
+	}
+
+	var buf bytes.Buffer
+	is.NoErr(book.Write(&buf))
+
+	names, files := readEPUB(t, buf.Bytes())
+
+	is.Equal(names[0], "mimetype")
+	is.Equal(files["mimetype"], "application/epub+zip")
+
+	for name, content := range files {
+		if strings.HasSuffix(name, ".xhtml") || strings.HasSuffix(name, ".opf") || strings.HasSuffix(name, ".xml") {
+			dec := xml.NewDecoder(strings.NewReader(content))
+			for {
+				_, err := dec.Token()
+				if err == io.EOF {
+					break
+				}
+				is.NoErr(err) // every XML document is well-formed
+			}
+		}
+	}
+
+	opf := files["OEBPS/content.opf"]
+	is.True(strings.Contains(opf, `<dc:title>Handbook</dc:title>`))
+	is.True(strings.Contains(opf, `<dc:creator>Docs Team</dc:creator>`))
+	is.True(strings.Contains(opf, `2023-11-14T22:13:20Z`)) // modified date from the document time
+	is.True(strings.Contains(opf, `href="images/diagram.png" media-type="image/png"`))
+	is.Equal(strings.Count(opf, "diagram.png"), 1) // images shared by chapters are packaged once
+	is.True(strings.Contains(opf, `<itemref idref="chapter-2"/>`))
+	is.True(strings.Contains(opf, `<item id="chapter-1" href="chapter-1.xhtml" media-type="application/xhtml+xml"/>`))
+	is.True(strings.Contains(opf, `<item id="chapter-2" href="chapter-2.xhtml" media-type="application/xhtml+xml" properties="remote-resources"/>`)) // the remote image is declared
+
+	nav := files["OEBPS/nav.xhtml"]
+	is.True(strings.Contains(nav, `<li><a href="chapter-1.xhtml">Getting started</a>`))
+	is.True(strings.Contains(nav, `<li><a href="chapter-1.xhtml#c1-h1">Getting started</a>`+"\n<ol>\n"+`<li><a href="chapter-1.xhtml#c1-h3">Install</a></li>`))
+	is.True(strings.Contains(nav, `<li><a href="chapter-2.xhtml#ref-top">Reference</a></li>`))
+	is.True(strings.Contains(nav, `<li><a href="chapter-2.xhtml">Appendix</a>`))
+
+	chapter := files["OEBPS/chapter-1.xhtml"]
+	is.True(strings.Contains(chapter, `<h1 id="c1-h1">`))
+	is.True(strings.Contains(chapter, `<br/>`))
+	is.True(strings.Contains(chapter, `src="images/diagram.png"`))
+	is.True(strings.Contains(files["OEBPS/chapter-2.xhtml"], `src="https://example.com/remote.jpg"`))
+}
+
+func TestWriteEmptyBook(t *testing.T) {
+	is := is.New(t)
+
+	is.True(Book{Title: "Empty"}.Write(io.Discard) != nil)
+}
@@ -0,0 +1,272 @@
+package gemtext
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type Renderer struct{}
+
+// withLinks writes the links found in a block as link lines after it.
+func withLinks(content string, links []link) string {
+	if len(links) == 0 {
+		return content
+	}
+	return content + "\n" + linkLines(links)
+}
+
+func (r Renderer) Header(el *domain.EditorJSDataHeader) string {
+	// Gemtext only has three heading levels.
+	level := el.Level
+	if level < 1 {
+		level = 1
+	} else if level > 3 {
+		level = 3
+	}
+
+	return strings.Repeat("#", level) + " " + plainText(el.Text)
+}
+
+func (r Renderer) Paragraph(el *domain.EditorJSDataParagraph) string {
+	return withLinks(text(el.Text))
+}
+
+func (r Renderer) Quote(el *domain.EditorJSDataQuote) string {
+	quote, links := text(el.Text)
+	quote = quoteLines(quote)
+
+	if el.Caption != "" {
+		caption, captionLinks := text(el.Caption)
+		quote += "\n> — " + oneLine(caption)
+		links = append(links, captionLinks...)
+	}
+
+	return withLinks(quote, links)
+}
+
+func (r Renderer) Warning(el *domain.EditorJSDataWarning) string {
+	message, links := text(el.Message)
+
+	warning := "> ⚠️ " + plainText(el.Title)
+	if el.Title == "" {
+		warning = "> ⚠️"
+	}
+
+	return withLinks(warning+"\n"+quoteLines(message), links)
+}
+
+func (r Renderer) Delimiter() string {
+	return "---"
+}
+
+func (r Renderer) Alert(el *domain.EditorJSDataAlert) string {
+	message, links := text(el.Message)
+	return withLinks(quoteLines(message), links)
+}
+
+func (r Renderer) List(el *domain.EditorJSDataList) (string, error) {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return "", err
+	}
+
+	var links []link
+	lines := nestedList(itemsList, el.Style == "unordered", "", &links)
+
+	return withLinks(strings.Join(lines, "\n"), links), nil
+}
+
+// nestedList flattens nested lists, as Gemtext lists have one level: nested
+// unordered items are marked by depth and ordered items are numbered by path
+// (1., 1.1., ...).
+func nestedList(items []domain.NestedListItem, unordered bool, prefix string, links *[]link) []string {
+	var result []string
+
+	for i, item := range items {
+		content, itemLinks := text(item.Content)
+		*links = append(*links, itemLinks...)
+		content = oneLine(content)
+
+		number := prefix + strconv.Itoa(i+1) + "."
+
+		if unordered {
+			result = append(result, "* "+prefix+content)
+		} else {
+			result = append(result, textLine(number+" "+content))
+		}
+
+		if len(item.Items) > 0 {
+			nested := number
+			if unordered {
+				nested = prefix + "◦ "
+			}
+			result = append(result, nestedList(item.Items, unordered, nested, links)...)
+		}
+	}
+
+	return result
+}
+
+func (r Renderer) Checklist(el *domain.EditorJSDataChecklist) string {
+	var result []string
+	var links []link
+
+	for _, item := range el.Items {
+		content, itemLinks := text(item.Text)
+		links = append(links, itemLinks...)
+
+		box := "☐"
+		if item.Checked {
+			box = "☑"
+		}
+		result = append(result, "* "+box+" "+oneLine(content))
+	}
+
+	return withLinks(strings.Join(result, "\n"), links)
+}
+
+// Table renders an ASCII table in a preformatted block.
+func (r Renderer) Table(el *domain.EditorJSDataTable) string {
+	var widths []int
+
+	rows := make([][]string, len(el.Content))
+	for i, line := range el.Content {
+		for column, info := range line {
+			cell := plainText(info)
+			rows[i] = append(rows[i], cell)
+
+			if column == len(widths) {
+				widths = append(widths, 0)
+			}
+			if width := utf8.RuneCountInString(cell); width > widths[column] {
+				widths[column] = width
+			}
+		}
+	}
+
+	if len(widths) == 0 {
+		return ""
+	}
+
+	var rule []string
+	for _, width := range widths {
+		rule = append(rule, strings.Repeat("-", width+2))
+	}
+	border := "+" + strings.Join(rule, "+") + "+"
+
+	result := []string{border}
+
+	for i, row := range rows {
+		line := "|"
+		for column, width := range widths {
+			cell := ""
+			if column < len(row) {
+				cell = row[column]
+			}
+			line += " " + cell + strings.Repeat(" ", width-utf8.RuneCountInString(cell)) + " |"
+		}
+
+		result = append(result, line)
+
+		if i == 0 && el.WithHeadings {
+			result = append(result, strings.ReplaceAll(border, "-", "="))
+		}
+	}
+
+	result = append(result, border)
+
+	return preformatted(strings.Join(result, "\n"), "table")
+}
+
+func (r Renderer) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return linkLine(el.Link, plainText(el.Text))
+}
+
+func (r Renderer) Code(el *domain.EditorJSDataCode) string {
+	return preformatted(el.Code, el.LanguageCode)
+}
+
+func (r Renderer) Raw(el *domain.EditorJSDataRaw) string {
+	return preformatted(el.Html, "html")
+}
+
+func (r Renderer) Image(el *domain.EditorJSDataImage) string {
+	url := el.URL
+	if el.File.URL != "" {
+		url = el.File.URL
+	}
+
+	caption := plainText(el.Caption)
+	if caption == "" {
+		caption = "Image"
+	}
+
+	return linkLine(url, caption)
+}
+
+func (r Renderer) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	title := plainText(el.Meta.Title)
+	if title == "" {
+		title = el.Link
+	}
+
+	if el.Meta.Description == "" {
+		return linkLine(el.Link, title)
+	}
+
+	description, links := text(el.Meta.Description)
+
+	return withLinks(description, append([]link{{URL: el.Link, Text: title}}, links...))
+}
+
+func (r Renderer) Attaches(el *domain.EditorJSDataAttaches) string {
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+
+	return linkLine(el.File.URL, name+" ("+support.HumanFileSize(el.File.Size)+")")
+}
+
+func (r Renderer) Embed(el *domain.EditorJSDataEmbed) string {
+	watch := link{URL: el.Source, Text: "Watch on " + el.Service}
+
+	if el.Caption == "" {
+		return linkLines([]link{watch})
+	}
+
+	caption, links := text(el.Caption)
+
+	return withLinks(caption, append([]link{watch}, links...))
+}
+
+func (r Renderer) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	var links []link
+
+	for index, url := range el.URLs {
+		links = append(links, link{URL: url, Text: "Image " + strconv.Itoa(index+1)})
+	}
+
+	return linkLines(links)
+}
+
+// preformatted wraps content in toggle lines; the alt text on the opening
+// line names the content for screen readers. Lines that would close the block
+// early are indented.
+func preformatted(content, alt string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "```") {
+			lines[i] = " " + line
+		}
+	}
+
+	return "```" + oneLine(alt) + "\n" + strings.Join(lines, "\n") + "\n```"
+}
+
+func quoteLines(text string) string {
+	return "> " + strings.ReplaceAll(text, "\n", "\n> ")
+}
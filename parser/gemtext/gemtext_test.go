@@ -0,0 +1,58 @@
+package gemtext
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func parse(t *testing.T, blocks string) string {
+	out, err := Renderer{}.Parse(`{"blocks": [`+blocks+`]}`, support.Limits{})
+	assert.NoError(t, err)
+	return out
+}
+
+func TestHeader(t *testing.T) {
+	assert.Equal(t, "# Title", parse(t, `{"type": "header", "data": {"level": 1, "text": "<b>Title</b>"}}`))
+	assert.Equal(t, "### Deep", parse(t, `{"type": "header", "data": {"level": 5, "text": "Deep"}}`))
+}
+
+func TestParagraphLinks(t *testing.T) {
+	expected := `Read the docs and the FAQ.
+=> https://example.com/docs the docs
+=> https://example.com/a%20b the FAQ`
+
+	assert.Equal(t, expected, parse(t, `{"type": "paragraph", "data": {"text": "Read <a href=\"https://example.com/docs\">the <b>docs</b></a> and <a href=\"https://example.com/a b\">the FAQ</a>."}}`))
+}
+
+func TestParagraphLineTypes(t *testing.T) {
+	assert.Equal(t, " # not a heading\n => not a link\nplain", parse(t, `{"type": "paragraph", "data": {"text": "# not a heading<br>=&gt; not a link<br>plain"}}`))
+}
+
+func TestNestedList(t *testing.T) {
+	items := `[{"content": "one", "items": [{"content": "two", "items": []}]}, {"content": "three", "items": []}]`
+
+	assert.Equal(t, "* one\n* ◦ two\n* three", parse(t, `{"type": "list", "data": {"style": "unordered", "items": `+items+`}}`))
+	assert.Equal(t, "1. one\n1.1. two\n2. three", parse(t, `{"type": "list", "data": {"style": "ordered", "items": `+items+`}}`))
+}
+
+func TestTable(t *testing.T) {
+	expected := "```table\n" + `+------+-------+
+| Name | Value |
++======+=======+
+| a    |       |
++------+-------+` + "\n```"
+
+	assert.Equal(t, expected, parse(t, `{"type": "table", "data": {"withHeadings": true, "content": [["Name", "Value"], ["a"]]}}`))
+}
+
+func TestCode(t *testing.T) {
+	assert.Equal(t, "```go\nfmt.Println()\n ```\n```", parse(t, `{"type": "code", "data": {"code": "fmt.Println()\n`+"```"+`", "languageCode": "go"}}`))
+}
+
+func TestLinkBlocks(t *testing.T) {
+	assert.Equal(t, "=> https://example.com Go", parse(t, `{"type": "AnyButton", "data": {"link": "https://example.com", "text": "Go"}}`))
+	assert.Equal(t, "A site\n=> https://example.com Example", parse(t, `{"type": "linkTool", "data": {"link": "https://example.com", "meta": {"title": "Example", "description": "A site"}}}`))
+	assert.Equal(t, "=> files/a.pdf a.pdf (1 KiB)", parse(t, `{"type": "attaches", "data": {"file": {"url": "files/a.pdf", "name": "a.pdf", "size": 1024}}}`))
+	assert.Equal(t, "A video\n=> https://youtu.be/x Watch on youtube", parse(t, `{"type": "embed", "data": {"service": "youtube", "source": "https://youtu.be/x", "caption": "A video"}}`))
+}
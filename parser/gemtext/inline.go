@@ -0,0 +1,76 @@
+package gemtext
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"strings"
+)
+
+// link is a link line; Gemtext has no inline links.
+type link struct {
+	URL  string
+	Text string
+}
+
+var urlEscaper = strings.NewReplacer(` `, `%20`, "\t", `%09`)
+
+// lineStarts are the prefixes Gemtext reads as a line type other than text.
+var lineStarts = []string{"=>", "```", "#", "* ", ">"}
+
+// text converts the inline HTML of Editor.js text into plain text lines and
+// the links found in it, which are written as link lines after the text.
+func text(s string) (string, []link) {
+	nodes := inline.Parse(s)
+
+	lines := strings.Split(inline.PlainText(nodes), "\n")
+	for i, line := range lines {
+		lines[i] = textLine(line)
+	}
+
+	return strings.Join(lines, "\n"), links(nodes)
+}
+
+func links(nodes []inline.Node) (result []link) {
+	for _, node := range nodes {
+		if node.Kind == inline.Link && node.Href != "" {
+			result = append(result, link{URL: node.Href, Text: oneLine(inline.PlainText(node.Children))})
+			continue
+		}
+		result = append(result, links(node.Children)...)
+	}
+	return
+}
+
+// textLine keeps a line of text from being read as another line type; a
+// leading space is the only escape Gemtext has.
+func textLine(line string) string {
+	for _, start := range lineStarts {
+		if strings.HasPrefix(line, start) {
+			return " " + line
+		}
+	}
+	return line
+}
+
+func linkLine(url, text string) string {
+	line := "=> " + urlEscaper.Replace(url)
+	if text = oneLine(text); text != "" {
+		line += " " + text
+	}
+	return line
+}
+
+func linkLines(links []link) string {
+	var result []string
+	for _, l := range links {
+		result = append(result, linkLine(l.URL, l.Text))
+	}
+	return strings.Join(result, "\n")
+}
+
+func plainText(s string) string {
+	return oneLine(inline.PlainText(inline.Parse(s)))
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,31 @@
+package gemtext
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"log"
+	"strings"
+)
+
+// Parser renders the document within support.DefaultLimits. Errors are
+// logged and give an empty string; ParserWithLimits returns them.
+func Parser(jsonstr string) string {
+	gemtextStr, err := ParserWithLimits(jsonstr, support.DefaultLimits)
+	if err != nil {
+		log.Println("Error parsing the input json\n", err)
+	}
+
+	return gemtextStr
+}
+
+func ParserWithLimits(jsonstr string, limits support.Limits) (string, error) {
+	return Renderer{}.Parse(jsonstr, limits)
+}
+
+func (r Renderer) Parse(jsonstr string, limits support.Limits) (string, error) {
+	result, err := support.RenderBlocks(jsonstr, limits, r)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(result[:], "\n\n"), nil
+}
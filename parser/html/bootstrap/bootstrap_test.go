@@ -272,7 +272,7 @@ func TestListBlock(t *testing.T) {
 <li class="list-group-item">Simple and powerful API</li>
 </ul>`
 
-	obj.List()
+	is.NoErr(obj.List())
 
 	actual1 := obj.Result[0]
 
@@ -360,51 +360,44 @@ func TestListBlock(t *testing.T) {
 	obj.Data = support.PrepareData(editorJSON2.Blocks[0])
 
 	expected2 := `<ol class="list-group">
-<li class="list-group-item">Cars</li>
+<li class="list-group-item">Cars
 <ol class="">
-<li class="list-group-item">BMW</li>
+<li class="list-group-item">BMW
 <ol class="">
 <li class="list-group-item">Z3</li>
-</li>
 <li class="list-group-item">Z4</li>
-</li>
 </ol>
 </li>
-<li class="list-group-item">Audi</li>
+<li class="list-group-item">Audi
 <ol class="">
 <li class="list-group-item">A3</li>
-</li>
 <li class="list-group-item">A1</li>
-</li>
 </ol>
 </li>
 </ol>
 </li>
-<li class="list-group-item">Motorcycle</li>
+<li class="list-group-item">Motorcycle
 <ol class="">
-<li class="list-group-item">Ducati</li>
+<li class="list-group-item">Ducati
 <ol class="">
 <li class="list-group-item">916</li>
-</li>
 </ol>
 </li>
-<li class="list-group-item">Yamanha</li>
+<li class="list-group-item">Yamanha
 <ol class="">
 <li class="list-group-item">DT 180</li>
-</li>
 </ol>
 </li>
-<li class="list-group-item">Honda</li>
+<li class="list-group-item">Honda
 <ol class="">
 <li class="list-group-item">VFR 750R</li>
-</li>
 </ol>
 </li>
 </ol>
 </li>
 </ol>`
 
-	obj.List()
+	is.NoErr(obj.List())
 
 	actual2 := obj.Result[0]
 
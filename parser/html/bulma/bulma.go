@@ -135,9 +135,15 @@ func (o *Object) Alert() {
 	o.Result = append(o.Result, strings.Join(output[:], "\n"))
 }
 
-func (o *Object) List() {
+func (o *Object) List() error {
 	obj := o.Data.(*domain.EditorJSDataList)
-	o.Result = append(o.Result, fmt.Sprintf(`<div class="content">%s</div>`, common.List(obj)))
+	output, err := common.List(obj)
+	if err != nil {
+		return err
+	}
+
+	o.Result = append(o.Result, fmt.Sprintf(`<div class="content">%s</div>`, output))
+	return nil
 }
 
 func (o *Object) Checklist() {
@@ -225,7 +231,7 @@ func (o *Object) Attaches() {
 	output = append(output, `<a href="`+obj.File.URL+`" rel="noopener noreferrer" target="_blank" class="`+sup.SM.Blocks.Attaches.Link+`">`,
 		`<div class="`+sup.SM.Blocks.Attaches.Container+`">`,
 		`<div class="`+sup.SM.Blocks.Attaches.LeftColumn+`" >`,
-		`<img class="`+sup.SM.Blocks.Attaches.LeftImage+`" src="https://i.ibb.co/K7Myr2k/file-icon.png" />`,
+		`<img class="`+sup.SM.Blocks.Attaches.LeftImage+`" src="`+config.FileIconURL+`" />`,
 		`</div>`,
 		`<div class="`+sup.SM.Blocks.Attaches.CenterColumn+`">`,
 		`<div class="`+sup.SM.Blocks.Attaches.Filename+`">`,
@@ -236,7 +242,7 @@ func (o *Object) Attaches() {
 		`</div>`,
 		`</div>`,
 		`<div class="`+sup.SM.Blocks.Attaches.RightColumn+`" >`,
-		`<img class="`+sup.SM.Blocks.Attaches.RightImage+`" src="https://i.ibb.co/VYyHr6C/download-icon.png" />`,
+		`<img class="`+sup.SM.Blocks.Attaches.RightImage+`" src="`+config.DownloadIconURL+`" />`,
 		`</div>`,
 		`</div>`,
 		`</a>`)
@@ -273,7 +273,7 @@ func TestListBlock(t *testing.T) {
 <li class="">Simple and powerful API</li>
 </ul></div>`
 
-	obj.List()
+	is.NoErr(obj.List())
 
 	actual1 := obj.Result[0]
 
@@ -361,51 +361,44 @@ func TestListBlock(t *testing.T) {
 	obj.Data = support.PrepareData(editorJSON2.Blocks[0])
 
 	expected2 := `<div class="content"><ol class="content">
-<li class="">Cars</li>
+<li class="">Cars
 <ol class="">
-<li class="">BMW</li>
+<li class="">BMW
 <ol class="">
 <li class="">Z3</li>
-</li>
 <li class="">Z4</li>
-</li>
 </ol>
 </li>
-<li class="">Audi</li>
+<li class="">Audi
 <ol class="">
 <li class="">A3</li>
-</li>
 <li class="">A1</li>
-</li>
 </ol>
 </li>
 </ol>
 </li>
-<li class="">Motorcycle</li>
+<li class="">Motorcycle
 <ol class="">
-<li class="">Ducati</li>
+<li class="">Ducati
 <ol class="">
 <li class="">916</li>
-</li>
 </ol>
 </li>
-<li class="">Yamanha</li>
+<li class="">Yamanha
 <ol class="">
 <li class="">DT 180</li>
-</li>
 </ol>
 </li>
-<li class="">Honda</li>
+<li class="">Honda
 <ol class="">
 <li class="">VFR 750R</li>
-</li>
 </ol>
 </li>
 </ol>
 </li>
 </ol></div>`
 
-	obj.List()
+	is.NoErr(obj.List())
 
 	actual2 := obj.Result[0]
 
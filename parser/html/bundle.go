@@ -0,0 +1,318 @@
+package html
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	BundleIndex      = "index.html"
+	BundleStyleFile  = "assets/bundle.css"
+	BundleScriptFile = "assets/bundle.js"
+	BundleImagesDir  = "images/"
+	BundleFilesDir   = "files/"
+)
+
+// ErrNotFetched is returned by a Fetcher for URLs it leaves to the network;
+// those URLs are kept as they are in the bundle.
+var ErrNotFetched = errors.New("editorjs: asset not fetched")
+
+type Fetcher interface {
+	Fetch(url string) ([]byte, error)
+}
+
+type FetcherFunc func(url string) ([]byte, error)
+
+func (f FetcherFunc) Fetch(url string) ([]byte, error) {
+	return f(url)
+}
+
+// LocalFetcher reads relative paths from FS, for example os.DirFS(dir), and
+// skips URLs with other schemes. Absolute paths, file:// URLs and paths that
+// leave the root of FS are rejected, since the document is untrusted.
+type LocalFetcher struct {
+	FS fs.FS
+}
+
+var errNoFS = errors.New("editorjs: LocalFetcher has no FS")
+
+func (l LocalFetcher) Fetch(rawURL string) ([]byte, error) {
+	if strings.HasPrefix(rawURL, "//") {
+		return nil, ErrNotFetched
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, ErrNotFetched
+	}
+
+	if u.Scheme != "" && u.Scheme != "file" {
+		return nil, ErrNotFetched
+	}
+
+	// the query and fragment, as in cat.png?v=2, aren't part of the file name
+	name := u.Path
+
+	if l.FS == nil {
+		return nil, errNoFS
+	}
+
+	name, err = support.LocalPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.ReadFile(l.FS, name)
+}
+
+type BundleOptions struct {
+	Limits        support.Limits
+	SelfContained bool
+	Fetcher       Fetcher
+}
+
+type BundleFile struct {
+	Name    string
+	Content []byte
+}
+
+type Bundle struct {
+	Files []BundleFile
+}
+
+var bundleScriptPattern = regexp.MustCompile(`(?s)<script>\n(.*?)\n</script>`)
+
+// styleRecorder takes the inline library styles set on a page renderer, so
+// they can go into the bundle's CSS file; other styles reach the page.
+type styleRecorder struct {
+	domain.EditorJSMethods
+	styles []string
+}
+
+func (s *styleRecorder) SetStyles(styles []string) {
+	var rest []string
+
+	for _, style := range styles {
+		if strings.HasPrefix(style, "<style>") && strings.HasSuffix(style, "</style>") {
+			s.styles = append(s.styles, strings.TrimSuffix(strings.TrimPrefix(style, "<style>"), "</style>"))
+		} else {
+			rest = append(rest, style)
+		}
+	}
+
+	s.EditorJSMethods.SetStyles(rest)
+}
+
+// NewBundle renders the document as index.html, moving the page's inline CSS
+// and JS into their own files and copying the images and attachments it
+// references next to it.
+func NewBundle(jsonstr, styleName string, options BundleOptions) (*Bundle, error) {
+	editorJSON, err := support.ParseEditorJSONWithLimits(jsonstr, options.Limits)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := NewAssetCollector(options.Fetcher)
+	if err = assets.Collect(&editorJSON); err != nil {
+		return nil, err
+	}
+
+	rewritten, err := json.Marshal(editorJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := rendererFor(styleName)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := &styleRecorder{EditorJSMethods: f}
+	if err = renderPage(recorder, string(rewritten), options.Limits, options.SelfContained); err != nil {
+		return nil, err
+	}
+
+	page := recorder.CreatePage()
+	styles := recorder.styles
+
+	var scripts []string
+	page = bundleScriptPattern.ReplaceAllStringFunc(page, func(match string) string {
+		scripts = append(scripts, bundleScriptPattern.FindStringSubmatch(match)[1])
+		return `<script src="` + BundleScriptFile + `"></script>`
+	})
+
+	if len(styles) > 0 {
+		page = strings.Replace(page, "</head>", `<link rel="stylesheet" href="`+BundleStyleFile+`">`+"\n  </head>", 1)
+	}
+
+	files := []BundleFile{{Name: BundleIndex, Content: []byte(page)}}
+	if len(styles) > 0 {
+		files = append(files, BundleFile{Name: BundleStyleFile, Content: []byte(strings.Join(styles, "\n"))})
+	}
+	if len(scripts) > 0 {
+		files = append(files, BundleFile{Name: BundleScriptFile, Content: []byte(strings.Join(scripts, "\n"))})
+	}
+
+	return &Bundle{Files: append(files, assets.Files...)}, nil
+}
+
+func (b *Bundle) WriteDir(dir string) error {
+	for _, file := range b.Files {
+		name := filepath.Join(dir, filepath.FromSlash(file.Name))
+
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(name, file.Content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *Bundle) WriteZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	for _, file := range b.Files {
+		fw, err := zw.Create(file.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, err = fw.Write(file.Content); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// AssetCollector copies the images and attachments referenced by documents
+// into bundle files and points the blocks at them. One collector can be used
+// for several documents sharing the same output.
+type AssetCollector struct {
+	Fetcher Fetcher
+	// SkipAttachments keeps the URLs of attaches blocks as they are.
+	SkipAttachments bool
+	Files           []BundleFile
+
+	paths map[string]string
+	names map[string]bool
+}
+
+func NewAssetCollector(fetcher Fetcher) *AssetCollector {
+	return &AssetCollector{Fetcher: fetcher, paths: map[string]string{}, names: map[string]bool{}}
+}
+
+func (a *AssetCollector) Collect(editorJSON *domain.EditorJS) (err error) {
+	for _, el := range editorJSON.Blocks {
+		data, _ := el.Data.(map[string]interface{})
+		if data == nil {
+			continue
+		}
+
+		switch el.Type {
+		case "image":
+			err = a.rewrite(data, BundleImagesDir, "url")
+			if err == nil {
+				err = a.rewrite(nested(data, "file"), BundleImagesDir, "url")
+			}
+		case "attaches":
+			if !a.SkipAttachments {
+				err = a.rewrite(nested(data, "file"), BundleFilesDir, "url")
+			}
+		case "linkTool":
+			err = a.rewrite(nested(nested(data, "meta"), "image"), BundleImagesDir, "url")
+		case "imageGallery":
+			urls, _ := data["urls"].([]interface{})
+			for i, u := range urls {
+				if s, ok := u.(string); ok && err == nil {
+					urls[i], err = a.localPath(s, BundleImagesDir)
+				}
+			}
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (a *AssetCollector) rewrite(data map[string]interface{}, dir, key string) (err error) {
+	if s, ok := data[key].(string); ok && s != "" {
+		data[key], err = a.localPath(s, dir)
+	}
+	return
+}
+
+// localPath fetches rawURL once and returns its path inside the bundle, or
+// rawURL itself when the fetcher leaves it alone.
+func (a *AssetCollector) localPath(rawURL, dir string) (string, error) {
+	if a.Fetcher == nil || rawURL == "" || strings.HasPrefix(rawURL, "data:") {
+		return rawURL, nil
+	}
+
+	if p, ok := a.paths[rawURL]; ok {
+		return p, nil
+	}
+
+	content, err := a.Fetcher.Fetch(rawURL)
+	if errors.Is(err, ErrNotFetched) {
+		a.paths[rawURL] = rawURL
+		return rawURL, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("editorjs: fetching %s: %w", rawURL, err)
+	}
+
+	name := assetName(rawURL)
+	p := dir + name
+	for i := 1; a.names[p]; i++ {
+		p = dir + strconv.Itoa(i) + "-" + name
+	}
+
+	a.names[p] = true
+	a.paths[rawURL] = p
+	a.Files = append(a.Files, BundleFile{Name: p, Content: content})
+
+	return p, nil
+}
+
+func assetName(rawURL string) string {
+	p := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		p = u.Path
+	}
+
+	name := path.Base(p)
+	if name == "." || name == "/" || name == "" {
+		name = "asset"
+	}
+
+	return name
+}
+
+func nested(data map[string]interface{}, key string) map[string]interface{} {
+	child, _ := data[key].(map[string]interface{})
+	if child == nil {
+		return map[string]interface{}{}
+	}
+	return child
+}
@@ -0,0 +1,139 @@
+package html
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"github.com/matryer/is"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const bundleInput = `{
+    "blocks": [
+        {
+            "type": "image",
+            "data": {
+                "file": {
+                    "url": "media/cat.png"
+                },
+                "caption": "Cat"
+            }
+        },
+        {
+            "type": "attaches",
+            "data": {
+                "file": {
+                    "url": "./docs/report.pdf",
+                    "size": 3,
+                    "name": "report.pdf"
+                }
+            }
+        },
+        {
+            "type": "imageGallery",
+            "data": {
+                "layoutDefault": true,
+                "urls": ["media/cat.png", "other/cat.png", "https://example.com/remote.jpg"]
+            }
+        }
+    ]
+}`
+
+var bundleFS = fstest.MapFS{
+	"media/cat.png":   &fstest.MapFile{Data: []byte("cat")},
+	"other/cat.png":   &fstest.MapFile{Data: []byte("another cat")},
+	"docs/report.pdf": &fstest.MapFile{Data: []byte("pdf")},
+}
+
+func TestBundle(t *testing.T) {
+	is := is.New(t)
+
+	b, err := NewBundle(bundleInput, "bootstrap", BundleOptions{Fetcher: LocalFetcher{FS: bundleFS}})
+	is.NoErr(err)
+
+	files := map[string]string{}
+	for _, file := range b.Files {
+		files[file.Name] = string(file.Content)
+	}
+
+	is.Equal(b.Files[0].Name, BundleIndex)
+	is.Equal(files["images/cat.png"], "cat")
+	is.Equal(files["images/1-cat.png"], "another cat") // name collisions get a prefix
+	is.Equal(files["files/report.pdf"], "pdf")
+
+	index := files[BundleIndex]
+	is.True(strings.Contains(index, `src="images/cat.png"`))
+	is.True(strings.Contains(index, `src="images/1-cat.png"`))
+	is.True(strings.Contains(index, `href="files/report.pdf"`))
+	is.True(strings.Contains(index, `src="https://example.com/remote.jpg"`)) // remote URLs are kept
+	is.True(strings.Contains(index, `<script src="`+BundleScriptFile+`"></script>`))
+	is.True(strings.Contains(index, `<link rel="stylesheet" href="`+BundleStyleFile+`">`))
+	is.True(!strings.Contains(index, "<style>")) // CSS is extracted
+	is.True(strings.Contains(files[BundleStyleFile], ".gg-container"))
+	is.True(strings.Contains(files[BundleScriptFile], "GalleryGrid"))
+
+	dir := t.TempDir()
+	is.NoErr(b.WriteDir(dir))
+	content, err := os.ReadFile(filepath.Join(dir, "images", "1-cat.png"))
+	is.NoErr(err)
+	is.Equal(string(content), "another cat")
+
+	var buf bytes.Buffer
+	is.NoErr(b.WriteZip(&buf))
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	is.NoErr(err)
+	is.Equal(len(zr.File), len(b.Files))
+}
+
+func TestBundleFetchError(t *testing.T) {
+	is := is.New(t)
+
+	_, err := NewBundle(bundleInput, "bootstrap", BundleOptions{Fetcher: LocalFetcher{FS: fstest.MapFS{}}})
+	is.True(err != nil) // missing local files are reported
+}
+
+func TestLocalFetcherStaysInRoot(t *testing.T) {
+	is := is.New(t)
+
+	fetcher := LocalFetcher{FS: bundleFS}
+
+	content, err := fetcher.Fetch("./media/../media/cat.png")
+	is.NoErr(err)
+	is.Equal(string(content), "cat")
+
+	for _, rawURL := range []string{"/etc/passwd", "../../etc/passwd", "media/../../secret", "file:///etc/passwd"} {
+		_, err = fetcher.Fetch(rawURL)
+		is.True(errors.Is(err, fs.ErrInvalid)) // paths outside the root are rejected
+	}
+
+	_, err = LocalFetcher{}.Fetch("media/cat.png")
+	is.True(err != nil) // there is no fallback to the working directory
+
+	content, err = fetcher.Fetch("media/cat.png?v=2#top")
+	is.NoErr(err)
+	is.Equal(string(content), "cat") // the query and fragment aren't part of the name
+}
+
+func TestBundleKeepsDocumentStyles(t *testing.T) {
+	is := is.New(t)
+
+	b, err := NewBundle(`{"blocks": [
+		{"type": "paragraph", "data": {"text": "<style>.mine{color:red}</style>"}},
+		{"type": "image", "data": {"file": {"url": "media/cat.png?v=2"}}},
+		{"type": "imageGallery", "data": {"urls": ["https://example.com/1.jpg"]}}
+	]}`, "bootstrap", BundleOptions{Fetcher: LocalFetcher{FS: bundleFS}})
+	is.NoErr(err)
+
+	index := string(b.Files[0].Content)
+	is.True(strings.Contains(index, "<style>.mine{color:red}</style>")) // styles in the document stay in place
+	is.True(strings.Contains(index, `src="images/cat.png"`))
+
+	is.Equal(b.Files[1].Name, BundleStyleFile)
+	is.True(strings.Contains(string(b.Files[1].Content), ".gg-container"))
+	is.True(!strings.Contains(string(b.Files[1].Content), ".mine"))
+}
@@ -1,11 +1,10 @@
 package common
 
 import (
-	"encoding/json"
 	"fmt"
 	sup "github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/config"
 	"github.com/banjuanshu/go-editorjs/support/domain"
-	"log"
 	"strconv"
 	"strings"
 )
@@ -110,67 +109,40 @@ func Alert(el *domain.EditorJSDataAlert) string {
 	return strings.Join(output[:], "\n")
 }
 
-func List(el *domain.EditorJSDataList) string {
-	var output []string
-
+func List(el *domain.EditorJSDataList) (string, error) {
 	listStyle := "ol"
 	if el.Style == "unordered" {
 		listStyle = "ul"
 	}
 
-	items, err := json.Marshal(el.Items)
+	itemsList, err := sup.ListItems(el.Items)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	var itemsList []domain.NestedListItem
-
-	err = json.Unmarshal(items, &itemsList)
-	if err == nil {
-		output = append(output, sup.CreateHTMLNestedList(itemsList, listStyle, true))
-	} else {
-		output = append(output, `<`+listStyle+` class="`+sup.SM.Blocks.List.Group+`">`)
-
-		for _, item := range el.Items {
-			output = append(output, `<li class="`+sup.SM.Blocks.List.Item+`">`+fmt.Sprintf("%v", item)+`</li>`)
-		}
-
-		output = append(output, `</`+listStyle+`>`)
+		return "", err
 	}
 
-	return strings.Join(output[:], "\n")
+	return sup.CreateHTMLNestedList(itemsList, listStyle, true), nil
 }
 
 func Checklist(el *domain.EditorJSDataChecklist) string {
 	var output []string
 
-	items, err := json.Marshal(el.Items)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	var itemsList []domain.ChecklistItem
-
-	err = json.Unmarshal(items, &itemsList)
-	if err == nil {
-		output = append(output, `<div class="`+sup.SM.Blocks.Checklist.Block+`">`)
+	output = append(output, `<div class="`+sup.SM.Blocks.Checklist.Block+`">`)
 
-		for _, item := range itemsList {
-			output = append(output, `<div class="`+sup.SM.Blocks.Checklist.Item+`">`)
+	for _, item := range el.Items {
+		output = append(output, `<div class="`+sup.SM.Blocks.Checklist.Item+`">`)
 
-			if item.Checked {
-				output = append(output, `<span class="`+sup.SM.Blocks.Checklist.CheckboxChecked+`">&#10004;</span>`)
-			} else {
-				output = append(output, `<span class="`+sup.SM.Blocks.Checklist.CheckboxUnchecked+`">&nbsp;-&nbsp;</span>`)
-			}
-
-			output = append(output, `<span class="`+sup.SM.Blocks.Checklist.Text+`">`+item.Text+`</span>`,
-				`</div>`)
+		if item.Checked {
+			output = append(output, `<span class="`+sup.SM.Blocks.Checklist.CheckboxChecked+`">&#10004;</span>`)
+		} else {
+			output = append(output, `<span class="`+sup.SM.Blocks.Checklist.CheckboxUnchecked+`">&nbsp;-&nbsp;</span>`)
 		}
 
-		output = append(output, `</div>`)
+		output = append(output, `<span class="`+sup.SM.Blocks.Checklist.Text+`">`+item.Text+`</span>`,
+			`</div>`)
 	}
 
+	output = append(output, `</div>`)
+
 	return strings.Join(output[:], "\n")
 }
 
@@ -292,7 +264,7 @@ func Attaches(el *domain.EditorJSDataAttaches) string {
 		`<div class="`+sup.SM.Blocks.Attaches.Container+`">`,
 		`<div class="`+sup.SM.Blocks.Attaches.Row+`" >`,
 		`<div class="`+sup.SM.Blocks.Attaches.LeftColumn+`" >`,
-		`<img class="`+sup.SM.Blocks.Attaches.LeftImage+`" src="https://i.ibb.co/K7Myr2k/file-icon.png" />`,
+		`<img class="`+sup.SM.Blocks.Attaches.LeftImage+`" src="`+config.FileIconURL+`" />`,
 		`</div>`,
 		`<div class="`+sup.SM.Blocks.Attaches.CenterColumn+`">`,
 		`<div class="`+sup.SM.Blocks.Attaches.Filename+`">`,
@@ -303,7 +275,7 @@ func Attaches(el *domain.EditorJSDataAttaches) string {
 		`</div>`,
 		`</div>`,
 		`<div class="`+sup.SM.Blocks.Attaches.RightColumn+`" >`,
-		`<img class="`+sup.SM.Blocks.Attaches.RightImage+`" src="https://i.ibb.co/VYyHr6C/download-icon.png" />`,
+		`<img class="`+sup.SM.Blocks.Attaches.RightImage+`" src="`+config.DownloadIconURL+`" />`,
 		`</div>`,
 		`</div>`,
 		`</div>`,
@@ -0,0 +1,456 @@
+package email
+
+import (
+	sup "github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/config"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Object renders blocks as rows of a fixed-width layout table. The values of
+// its style map are CSS declarations, written to style attributes because
+// mail clients drop classes and style sheets.
+type Object struct {
+	Data   interface{}
+	Result []string
+}
+
+const (
+	StyleName = "email"
+	MapFile   = "email.json"
+)
+
+const (
+	// Width is the width of the content table, which common mail clients
+	// display without scaling.
+	Width = 600
+	// ImageWidth is the content width left by the 24px side padding of the
+	// default map's block cells.
+	ImageWidth = 552
+	// GalleryColumns is the number of thumbnails per gallery row.
+	GalleryColumns = 3
+	gallerySpacing = 8
+)
+
+const presentation = ` role="presentation" cellpadding="0" cellspacing="0" border="0"`
+
+func Init(useDefaultMap bool) (framework Object) {
+	if useDefaultMap {
+		sup.LoadStyleMap(MapFile)
+	}
+	return framework
+}
+
+func (o *Object) SetData(data interface{}) {
+	o.Data = data
+}
+
+// SetStyles drops the block libraries: mail clients don't load style sheets.
+func (o *Object) SetStyles(styles []string) {}
+
+func (o *Object) SetResult(result string) {
+	o.Result = append(o.Result, row(result))
+}
+
+// SetScripts drops the block scripts, which mail clients never run.
+func (o *Object) SetScripts(scripts []string) {}
+
+func (o *Object) LoadLibrary() {}
+
+func (o *Object) CreatePage() string {
+	page := `<!DOCTYPE html>
+<html>
+  <head>
+`
+	for _, h := range sup.SM.PageHead {
+		page += h + `
+`
+	}
+
+	return page + `  </head>
+  <body` + style(sup.SM.Elements["body"]) + `>
+` + o.GetHtml() + `
+</body>
+</html>
+`
+}
+
+func (o *Object) GetHtml() string {
+	return strings.Join([]string{
+		`<table` + presentation + ` width="100%"` + style(sup.SM.Elements["body"]) + `>`,
+		`<tr>`,
+		`<td align="center">`,
+		`<table` + presentation + ` width="` + strconv.Itoa(Width) + `"` + style(`width:100%;max-width:`+strconv.Itoa(Width)+`px`, sup.SM.Container) + `>`,
+		strings.Join(o.Result, "\n"),
+		`</table>`,
+		`</td>`,
+		`</tr>`,
+		`</table>`,
+	}, "\n")
+}
+
+// Separator does nothing: the block cells already carry the spacing from
+// spaceBetweenBlocks.
+func (o *Object) Separator() {}
+
+func (o *Object) Header() {
+	obj := o.Data.(*domain.EditorJSDataHeader)
+
+	level := obj.Level
+	if level < 1 || level > 6 {
+		level = 2
+	}
+
+	tag := `h` + strconv.Itoa(level)
+
+	o.SetResult(`<` + tag + style(sup.SM.Blocks.Header[tag]) + `>` + text(obj.Text) + `</` + tag + `>`)
+}
+
+func (o *Object) Paragraph() {
+	obj := o.Data.(*domain.EditorJSDataParagraph)
+	o.SetResult(`<p` + align(obj.Alignment) + style(sup.SM.Blocks.Paragraph, sup.SM.Alignment[obj.Alignment]) + `>` + text(obj.Text) + `</p>`)
+}
+
+func (o *Object) Quote() {
+	obj := o.Data.(*domain.EditorJSDataQuote)
+	var output []string
+
+	output = append(output, `<p`+style(sup.SM.Blocks.Quote.Blockquote)+`>`+text(obj.Text)+`</p>`)
+
+	if obj.Caption != "" {
+		output = append(output, `<p`+style(sup.SM.Blocks.Quote.Figcaption)+`>&mdash; `+text(obj.Caption)+`</p>`)
+	}
+
+	o.SetResult(box(strings.Join(output, "\n"), sup.SM.Blocks.Quote.Figure, sup.SM.Alignment[obj.Alignment]))
+}
+
+func (o *Object) Warning() {
+	obj := o.Data.(*domain.EditorJSDataWarning)
+	var output []string
+
+	if obj.Title != "" {
+		output = append(output, `<p`+style(sup.SM.Blocks.Warning.Title)+`><strong>`+text(obj.Title)+`</strong></p>`)
+	}
+
+	output = append(output, `<p`+style(sup.SM.Blocks.Paragraph)+`>`+text(obj.Message)+`</p>`)
+
+	o.SetResult(box(strings.Join(output, "\n"), sup.SM.Blocks.Warning.Block))
+}
+
+func (o *Object) Delimiter() {
+	o.SetResult(box(`&nbsp;`, sup.SM.Blocks.Delimiter))
+}
+
+func (o *Object) Alert() {
+	obj := o.Data.(*domain.EditorJSDataAlert)
+	o.SetResult(box(`<p`+style(sup.SM.Blocks.Paragraph)+`>`+text(obj.Message)+`</p>`, sup.SM.Blocks.Alert.Block, sup.SM.Blocks.Alert.Types[obj.Type]))
+}
+
+func (o *Object) List() error {
+	obj := o.Data.(*domain.EditorJSDataList)
+
+	listStyle := "ol"
+	if obj.Style == "unordered" {
+		listStyle = "ul"
+	}
+
+	itemsList, err := sup.ListItems(obj.Items)
+	if err != nil {
+		return err
+	}
+
+	o.SetResult(nestedList(itemsList, listStyle, sup.SM.Blocks.List.Group))
+	return nil
+}
+
+func nestedList(items []domain.NestedListItem, listStyle, group string) string {
+	var output []string
+
+	output = append(output, `<`+listStyle+style(group)+`>`)
+
+	for _, item := range items {
+		if len(item.Items) == 0 {
+			output = append(output, `<li`+style(sup.SM.Blocks.List.Item)+`>`+text(item.Content)+`</li>`)
+			continue
+		}
+
+		output = append(output, `<li`+style(sup.SM.Blocks.List.Item)+`>`+text(item.Content),
+			nestedList(item.Items, listStyle, sup.SM.Blocks.List.NestedGroup),
+			`</li>`)
+	}
+
+	output = append(output, `</`+listStyle+`>`)
+
+	return strings.Join(output[:], "\n")
+}
+
+// Checklist draws the boxes with characters, as mail clients drop form
+// controls.
+func (o *Object) Checklist() {
+	obj := o.Data.(*domain.EditorJSDataChecklist)
+	var output []string
+
+	output = append(output, `<table`+presentation+` width="100%"`+style(sup.SM.Blocks.Checklist.Block)+`>`)
+
+	for _, item := range obj.Items {
+		box := `<span` + style(sup.SM.Blocks.Checklist.CheckboxUnchecked) + `>&#9744;</span>`
+		if item.Checked {
+			box = `<span` + style(sup.SM.Blocks.Checklist.CheckboxChecked) + `>&#9745;</span>`
+		}
+
+		output = append(output, `<tr><td`+style(sup.SM.Blocks.Checklist.Item)+`>`+box+` <span`+style(sup.SM.Blocks.Checklist.Text)+`>`+text(item.Text)+`</span></td></tr>`)
+	}
+
+	output = append(output, `</table>`)
+
+	o.SetResult(strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Table() {
+	obj := o.Data.(*domain.EditorJSDataTable)
+	var output []string
+
+	output = append(output, `<table cellpadding="0" cellspacing="0" border="0" width="100%"`+style(sup.SM.Blocks.Table.Table)+`>`)
+
+	for index, line := range obj.Content {
+		tag, cellStyle := "td", sup.SM.Blocks.Table.CellTD
+		if obj.WithHeadings && index == 0 {
+			tag, cellStyle = "th", sup.SM.Blocks.Table.CellTH
+		}
+
+		lineData := `<tr` + style(sup.SM.Blocks.Table.Row) + `>`
+		for _, info := range line {
+			lineData += `<` + tag + style(cellStyle) + `>` + text(info) + `</` + tag + `>`
+		}
+
+		output = append(output, lineData+`</tr>`)
+	}
+
+	output = append(output, `</table>`)
+
+	o.SetResult(strings.Join(output[:], "\n"))
+}
+
+func (o *Object) AnyButton() {
+	obj := o.Data.(*domain.EditorJSDataAnyButton)
+
+	o.SetResult(strings.Join([]string{
+		`<table` + presentation + `>`,
+		`<tr><td><a href="` + attr(obj.Link) + `"` + style(sup.SM.Blocks.AnyButton) + `>` + text(obj.Text) + `</a></td></tr>`,
+		`</table>`,
+	}, "\n"))
+}
+
+func (o *Object) Code() {
+	obj := o.Data.(*domain.EditorJSDataCode)
+	o.SetResult(`<pre` + style(sup.SM.Blocks.Code.Pre) + `><code` + style(sup.SM.Blocks.Code.Code) + `>` + html.EscapeString(obj.Code) + `</code></pre>`)
+}
+
+func (o *Object) Raw() {
+	obj := o.Data.(*domain.EditorJSDataRaw)
+	o.SetResult(`<pre` + style(sup.SM.Blocks.Raw.Pre) + `><code` + style(sup.SM.Blocks.Raw.Code) + `>` + html.EscapeString(obj.Html) + `</code></pre>`)
+}
+
+func (o *Object) Image() {
+	obj := o.Data.(*domain.EditorJSDataImage)
+
+	url := obj.URL
+	if obj.File.URL != "" {
+		url = obj.File.URL
+	}
+
+	var declarations []string
+	if obj.WithBorder {
+		declarations = append(declarations, sup.SM.Blocks.Image.Border)
+	}
+	if obj.Stretched {
+		declarations = append(declarations, sup.SM.Blocks.Image.Stretched)
+	}
+	if obj.WithBackground {
+		declarations = append(declarations, sup.SM.Blocks.Image.Background)
+	}
+
+	var output []string
+
+	output = append(output, image(url, plainText(obj.Caption), ImageWidth, append([]string{sup.SM.Blocks.Image.Image}, declarations...)...))
+
+	if obj.Caption != "" {
+		output = append(output, `<p`+style(sup.SM.Elements["caption"])+`>`+text(obj.Caption)+`</p>`)
+	}
+
+	o.SetResult(box(strings.Join(output, "\n"), sup.SM.Blocks.Image.Block))
+}
+
+func (o *Object) LinkTool() {
+	obj := o.Data.(*domain.EditorJSDataLinkTool)
+	var output []string
+
+	title := text(obj.Meta.Title)
+	if title == "" {
+		title = html.EscapeString(obj.Link)
+	}
+
+	output = append(output, `<table`+presentation+` width="100%"`+style(sup.SM.Blocks.LinkTool.Container)+`>`,
+		`<tr`+style(sup.SM.Blocks.LinkTool.Row)+`>`)
+
+	if obj.Meta.Image.URL != "" {
+		output = append(output, `<td width="120" valign="top"`+style(sup.SM.Blocks.LinkTool.LeftColumn)+`><a href="`+attr(obj.Link)+`">`+image(obj.Meta.Image.URL, "", 120, sup.SM.Blocks.LinkTool.Image)+`</a></td>`)
+	}
+
+	output = append(output, `<td valign="top"`+style(sup.SM.Blocks.LinkTool.RightColumn)+`>`,
+		`<a href="`+attr(obj.Link)+`"`+style(sup.SM.Blocks.LinkTool.Link)+`><strong`+style(sup.SM.Blocks.LinkTool.Title)+`>`+title+`</strong></a>`)
+
+	if obj.Meta.Description != "" {
+		output = append(output, `<p`+style(sup.SM.Blocks.LinkTool.Description)+`>`+text(obj.Meta.Description)+`</p>`)
+	}
+
+	output = append(output, `<p`+style(sup.SM.Blocks.LinkTool.LinkDescription)+`>`+html.EscapeString(strings.ReplaceAll(strings.ReplaceAll(obj.Link, "https://", ""), "http://", ""))+`</p>`,
+		`</td>`,
+		`</tr>`,
+		`</table>`)
+
+	o.SetResult(strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Attaches() {
+	obj := o.Data.(*domain.EditorJSDataAttaches)
+
+	name := obj.File.Name
+	if name == "" {
+		name = obj.Title
+	}
+
+	o.SetResult(strings.Join([]string{
+		`<table` + presentation + ` width="100%"` + style(sup.SM.Blocks.Attaches.Container) + `>`,
+		`<tr` + style(sup.SM.Blocks.Attaches.Row) + `>`,
+		`<td width="40"` + style(sup.SM.Blocks.Attaches.LeftColumn) + `>` + image(config.FileIconURL, "", 32, sup.SM.Blocks.Attaches.LeftImage) + `</td>`,
+		`<td` + style(sup.SM.Blocks.Attaches.CenterColumn) + `><a href="` + attr(obj.File.URL) + `"` + style(sup.SM.Blocks.Attaches.Link) + `><span` + style(sup.SM.Blocks.Attaches.Filename) + `>` + html.EscapeString(name) + `</span></a> <span` + style(sup.SM.Blocks.Attaches.Size) + `>` + sup.HumanFileSize(obj.File.Size) + `</span></td>`,
+		`<td width="40" align="right"` + style(sup.SM.Blocks.Attaches.RightColumn) + `><a href="` + attr(obj.File.URL) + `">` + image(config.DownloadIconURL, "Download", 24, sup.SM.Blocks.Attaches.RightImage) + `</a></td>`,
+		`</tr>`,
+		`</table>`,
+	}, "\n"))
+}
+
+// Embed links a thumbnail of the video to its page, since mail clients don't
+// load iframes. Services without a known thumbnail get a plain link.
+func (o *Object) Embed() {
+	obj := o.Data.(*domain.EditorJSDataEmbed)
+	var output []string
+
+	if thumbnail := embedThumbnail(obj); thumbnail != "" {
+		output = append(output, `<a href="`+attr(obj.Source)+`">`+image(thumbnail, plainText(obj.Caption), ImageWidth, sup.SM.Elements["thumbnail"])+`</a>`)
+	}
+
+	caption := ""
+	if obj.Caption != "" {
+		caption = text(obj.Caption) + ` `
+	}
+
+	output = append(output, `<p`+style(sup.SM.Blocks.Embed.Title)+`>`+caption+`<a href="`+attr(obj.Source)+`"`+style(sup.SM.Blocks.Embed.Link)+`>Watch on `+html.EscapeString(obj.Service)+`</a></p>`)
+
+	o.SetResult(box(strings.Join(output, "\n"), sup.SM.Blocks.Embed.Block))
+}
+
+func embedThumbnail(obj *domain.EditorJSDataEmbed) string {
+	if obj.Service != "youtube" {
+		return ""
+	}
+
+	u, err := url.Parse(obj.Embed)
+	if err != nil {
+		return ""
+	}
+
+	id := strings.TrimPrefix(u.Path, "/embed/")
+	if id == "" || strings.Contains(id, "/") {
+		return ""
+	}
+
+	return "https://img.youtube.com/vi/" + url.PathEscape(id) + "/hqdefault.jpg"
+}
+
+// ImageGallery lays the images out as rows of linked thumbnails.
+func (o *Object) ImageGallery() {
+	obj := o.Data.(*domain.EditorJSDataImageGallery)
+	var output []string
+
+	width := (ImageWidth - gallerySpacing*(GalleryColumns-1)) / GalleryColumns
+
+	output = append(output, `<table`+presentation+` width="100%">`)
+
+	for start := 0; start < len(obj.URLs); start += GalleryColumns {
+		output = append(output, `<tr>`)
+
+		for index := start; index < start+GalleryColumns; index++ {
+			padding := ""
+			if index%GalleryColumns < GalleryColumns-1 {
+				padding = `padding-right:` + strconv.Itoa(gallerySpacing) + `px`
+			}
+
+			cell := `&nbsp;`
+			if index < len(obj.URLs) {
+				cell = `<a href="` + attr(obj.URLs[index]) + `">` + image(obj.URLs[index], "Image "+strconv.Itoa(index+1), width, sup.SM.Elements["thumbnail"]) + `</a>`
+			}
+
+			output = append(output, `<td width="`+strconv.Itoa(width)+`" valign="top"`+style(`padding-bottom:`+strconv.Itoa(gallerySpacing)+`px`, padding)+`>`+cell+`</td>`)
+		}
+
+		output = append(output, `</tr>`)
+	}
+
+	output = append(output, `</table>`)
+
+	o.SetResult(strings.Join(output[:], "\n"))
+}
+
+// row wraps a block in a row of the content table, styled with
+// spaceBetweenBlocks.
+func row(content string) string {
+	return "<tr>\n<td" + style(sup.SM.SpaceBetweenBlocks) + ">\n" + content + "\n</td>\n</tr>"
+}
+
+// box wraps content in a single-cell table, which mail clients style more
+// reliably than blocks such as div or blockquote.
+func box(content string, declarations ...string) string {
+	return `<table` + presentation + ` width="100%">` + "\n<tr>\n<td" + style(declarations...) + ">\n" + content + "\n</td>\n</tr>\n</table>"
+}
+
+// image sets the width attribute too, as some clients ignore CSS widths.
+func image(src, alt string, width int, declarations ...string) string {
+	return `<img src="` + attr(src) + `" alt="` + attr(alt) + `" width="` + strconv.Itoa(width) + `"` + style(append([]string{`display:block;max-width:100%;height:auto;border:0`}, declarations...)...) + ` />`
+}
+
+// style joins the non-empty declarations into a style attribute.
+func style(declarations ...string) string {
+	var parts []string
+	for _, d := range declarations {
+		if d = strings.Trim(strings.TrimSpace(d), ";"); d != "" {
+			parts = append(parts, d)
+		}
+	}
+
+	if len(parts) == 0 {
+		return ""
+	}
+
+	return ` style="` + attr(strings.Join(parts, ";")) + `"`
+}
+
+func align(alignment string) string {
+	if alignment == "center" || alignment == "right" {
+		return ` align="` + alignment + `"`
+	}
+	return ""
+}
+
+func attr(value string) string {
+	return html.EscapeString(value)
+}
+
+func plainText(s string) string {
+	return inline.PlainText(inline.Parse(s))
+}
@@ -0,0 +1,135 @@
+package email
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+)
+
+var obj = Init(true)
+
+func TestParagraphBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "paragraph",
+            "data": {
+                "text": "Centered <b>text</b> with <code class=\"inline-code\">code</code> and <a href=\"https://example.com\">a link</a>",
+                "alignment": "center"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataParagraph)
+
+	obj.Paragraph()
+
+	is.Equal(len(obj.Result), 1)
+	is.True(strings.HasPrefix(obj.Result[0], "<tr>\n<td style=\"padding: 0 24px 16px 24px;")) // blocks are rows of the layout table
+	is.True(strings.Contains(obj.Result[0], `<p align="center" style="margin: 0;text-align: center">Centered <strong>text</strong>`))
+	is.True(strings.Contains(obj.Result[0], `<code style="font-family: Consolas, Menlo, monospace;`)) // inline code is styled inline
+	is.True(strings.Contains(obj.Result[0], `<a href="https://example.com" style="color: #1a73e8;`))  // links are styled inline
+	is.True(!strings.Contains(obj.Result[0], `class=`))                                               // no classes are left
+}
+
+func TestAlertBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "alert",
+            "data": {
+                "type": "danger",
+                "message": "Careful"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataAlert)
+
+	obj.Alert()
+
+	is.True(strings.Contains(obj.Result[0], `<td style="padding: 12px 16px;background-color: #fdecea; color: #b71c1c">`)) // block and type declarations are combined
+}
+
+func TestEmbedBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "embed",
+            "data": {
+                "service": "youtube",
+                "source": "https://www.youtube.com/watch?v=abc123",
+                "embed": "https://www.youtube.com/embed/abc123",
+                "width": 580,
+                "height": 320,
+                "caption": "A video"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataEmbed)
+
+	obj.Embed()
+
+	is.True(!strings.Contains(obj.Result[0], `<iframe`))
+	is.True(strings.Contains(obj.Result[0], `<a href="https://www.youtube.com/watch?v=abc123"><img src="https://img.youtube.com/vi/abc123/hqdefault.jpg" alt="A video" width="552"`)) // linked thumbnail
+}
+
+func TestImageGalleryBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "imageGallery",
+            "data": {
+                "urls": ["https://example.com/1.jpg", "https://example.com/2.jpg", "https://example.com/3.jpg", "https://example.com/4.jpg"],
+                "layoutDefault": true
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataImageGallery)
+
+	obj.ImageGallery()
+
+	is.Equal(strings.Count(obj.Result[0], `<tr>`), 3)                          // the block row and two rows of thumbnails
+	is.Equal(strings.Count(obj.Result[0], `<a href="https://example.com/`), 4) // every thumbnail links to its image
+	is.Equal(strings.Count(obj.Result[0], `<td width="178"`), 6)               // fixed cell widths, including the empty cells
+	is.True(strings.Contains(obj.Result[0], `<img src="https://example.com/4.jpg" alt="Image 4" width="178"`))
+}
+
+func TestPageHasNoScripts(t *testing.T) {
+	is := is.New(t)
+
+	f := Init(true)
+	f.SetScripts([]string{"alert(1)"})
+	f.SetStyles([]string{"<style>.gg-container{}</style>"})
+	f.SetResult("<p>Hello</p>")
+
+	page := f.CreatePage()
+
+	is.True(!strings.Contains(page, `<script`))
+	is.True(!strings.Contains(page, `<style`))
+	is.True(strings.Contains(page, `<table role="presentation" cellpadding="0" cellspacing="0" border="0" width="600" style="width:100%;max-width:600px;background-color: #ffffff">`))
+}
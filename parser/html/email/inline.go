@@ -0,0 +1,55 @@
+package email
+
+import (
+	sup "github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"html"
+)
+
+// inlineRenderer rewrites the inline markup of Editor.js text with the
+// declarations of the style map's elements, replacing the classes of inline
+// code and marker tools.
+type inlineRenderer struct{}
+
+func text(s string) string {
+	return inline.Convert(s, inlineRenderer{})
+}
+
+func (inlineRenderer) Text(text string) string {
+	return html.EscapeString(text)
+}
+
+func (inlineRenderer) Bold(inner string) string {
+	return `<strong>` + inner + `</strong>`
+}
+
+func (inlineRenderer) Italic(inner string) string {
+	return `<em>` + inner + `</em>`
+}
+
+func (inlineRenderer) Underline(inner string) string {
+	return `<u>` + inner + `</u>`
+}
+
+func (inlineRenderer) Strike(inner string) string {
+	return `<s>` + inner + `</s>`
+}
+
+func (inlineRenderer) Code(text string) string {
+	return `<code` + style(sup.SM.Elements["code"]) + `>` + html.EscapeString(text) + `</code>`
+}
+
+func (inlineRenderer) Mark(inner string) string {
+	return `<mark` + style(sup.SM.Elements["mark"]) + `>` + inner + `</mark>`
+}
+
+func (inlineRenderer) Link(inner, href string) string {
+	if href == "" {
+		return inner
+	}
+	return `<a href="` + attr(href) + `"` + style(sup.SM.Elements["link"]) + `>` + inner + `</a>`
+}
+
+func (inlineRenderer) Break() string {
+	return `<br />`
+}
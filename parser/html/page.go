@@ -0,0 +1,115 @@
+package html
+
+import (
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/config"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"html"
+	"io/fs"
+	"log"
+	"regexp"
+	"strings"
+)
+
+type PageOptions struct {
+	Limits support.Limits
+	// SelfContained inlines the local copies of the style's libraries and the
+	// embedded attachment icons, so the page doesn't need a network. It needs
+	// a style map that lists localLibraryPaths or has no libraries; of the
+	// built-in styles only sample, semantic and email qualify. Other styles
+	// can supply their copies through a custom map and SetAssetFS.
+	SelfContained bool
+	// Images, when set, is used to inline local image sources as data URIs.
+	Images fs.FS
+}
+
+var imgSrcPattern = regexp.MustCompile(`(<img\b[^>]*?\ssrc=")([^"]*)(")`)
+
+func Page(jsonstr, styleName string, options PageOptions) (string, error) {
+	f, err := render(jsonstr, styleName, options.Limits, options.SelfContained)
+	if err != nil {
+		return "", err
+	}
+
+	page := f.CreatePage()
+
+	if options.SelfContained {
+		page, err = inlineIcons(page)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if options.Images != nil {
+		page = inlineImages(page, options.Images)
+	}
+
+	return page, nil
+}
+
+// loadLocalLibrary replaces the style's remote libraries with the copies
+// listed in localLibraryPaths, read from the asset FS (see SetAssetFS).
+func loadLocalLibrary(f domain.EditorJSMethods) error {
+	libraryPaths := support.SM.LibraryPaths
+
+	if len(libraryPaths) > 0 && len(support.SM.LocalLibraryPaths) == 0 {
+		return fmt.Errorf("editorjs: style %s has no local library copies", support.SM.StyleName)
+	}
+
+	support.SM.LibraryPaths = nil
+	f.LoadLibrary()
+	support.SM.LibraryPaths = libraryPaths
+
+	var styles []string
+
+	for _, l := range support.SM.LocalLibraryPaths {
+		if _, err := support.ReadAssetFile(config.AssetsStylePath + l); err != nil {
+			return fmt.Errorf("editorjs: local copy of library %s not found: %w", l, err)
+		}
+
+		styles = append(styles, `<style>`+string(support.MinifyAsset(config.AssetsStylePath+l, "css"))+`</style>`)
+	}
+
+	f.SetStyles(styles)
+
+	return nil
+}
+
+func inlineIcons(page string) (string, error) {
+	var replacements []string
+
+	for url, icon := range map[string]string{
+		config.FileIconURL:     "file_icon.png",
+		config.DownloadIconURL: "download_icon.png",
+	} {
+		content, err := support.ReadAssetFile(config.AssetsImagePath + icon)
+		if err != nil {
+			return "", err
+		}
+		replacements = append(replacements, url, support.DataURI(icon, content))
+	}
+
+	return strings.NewReplacer(replacements...).Replace(page), nil
+}
+
+func inlineImages(page string, images fs.FS) string {
+	return imgSrcPattern.ReplaceAllStringFunc(page, func(tag string) string {
+		match := imgSrcPattern.FindStringSubmatch(tag)
+		src := html.UnescapeString(match[2])
+
+		if src == "" || strings.HasPrefix(src, "data:") || strings.Contains(src, "://") || strings.HasPrefix(src, "//") {
+			return tag
+		}
+
+		name := strings.TrimPrefix(strings.TrimPrefix(src, "./"), "/")
+
+		content, err := fs.ReadFile(images, name)
+		if err != nil {
+			log.Println("Error inlining local image\n", err)
+			return tag
+		}
+
+		return match[1] + support.DataURI(name, content) + match[3]
+	})
+}
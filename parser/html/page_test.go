@@ -0,0 +1,85 @@
+package html
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/config"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const pageInput = `{
+    "blocks": [
+        {
+            "type": "attaches",
+            "data": {
+                "file": {
+                    "url": "https://example.com/report.pdf",
+                    "size": 1024,
+                    "name": "report.pdf"
+                },
+                "title": "Report"
+            }
+        },
+        {
+            "type": "image",
+            "data": {
+                "file": {
+                    "url": "images/cat.png"
+                },
+                "caption": "Cat"
+            }
+        }
+    ]
+}`
+
+func TestSelfContainedPage(t *testing.T) {
+	is := is.New(t)
+
+	_, err := Page(pageInput, "bootstrap", PageOptions{SelfContained: true})
+	is.True(err != nil) // frameworks have no local copies
+
+	images := fstest.MapFS{
+		"images/cat.png": &fstest.MapFile{Data: []byte("\x89PNG\r\n\x1a\n")},
+	}
+
+	page, err := Page(pageInput, "sample", PageOptions{SelfContained: true, Images: images})
+	is.NoErr(err)
+
+	is.True(!strings.Contains(page, `<link rel="stylesheet"`))               // no library links
+	is.True(!strings.Contains(page, config.FileIconURL))                     // icons are inlined
+	is.True(strings.Contains(page, `src="data:image/png;base64,`))           // icons and images use data URIs
+	is.True(!strings.Contains(page, `src="images/cat.png"`))                 // local images are inlined
+	is.True(strings.Contains(page, `href="https://example.com/report.pdf"`)) // links are left alone
+
+	support.SetAssetFS(fstest.MapFS{
+		"assets/css/sample.min.css": &fstest.MapFile{Data: []byte(".btn { color: red; }")},
+	})
+	defer support.SetAssetFS(nil)
+
+	page, err = Page(pageInput, "sample", PageOptions{SelfContained: true})
+	is.NoErr(err)
+	is.True(strings.Contains(page, `<style>.btn{color:red}</style>`)) // the local copy is inlined, from the overlay
+
+	page, err = Page(pageInput, "bootstrap", PageOptions{})
+	is.NoErr(err)
+	is.True(strings.Contains(page, "cdn.jsdelivr.net")) // regular pages still use the CDN
+	is.True(strings.HasPrefix(page, "<!DOCTYPE html>"))
+}
+
+func TestSelfContainedStyles(t *testing.T) {
+	is := is.New(t)
+
+	for _, name := range support.Styles() {
+		style, _ := support.LookupStyle(name)
+
+		_, err := Page(pageInput, name, PageOptions{SelfContained: true})
+
+		if len(style.Map.LocalLibraryPaths) > 0 || len(style.Map.LibraryPaths) == 0 {
+			is.NoErr(err) // every listed local copy exists
+		} else {
+			is.True(err != nil) // styles without local copies say so
+		}
+	}
+}
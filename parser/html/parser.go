@@ -1,119 +1,153 @@
 package html
 
 import (
+	"errors"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"html/template"
 	"log"
-	"os"
-	"rbsite/internal/editorjs/parser/html/bootstrap"
-	"rbsite/internal/editorjs/parser/html/bulma"
-	"rbsite/internal/editorjs/parser/html/sample"
-	"rbsite/internal/editorjs/support"
-	"rbsite/internal/editorjs/support/config"
-	"rbsite/internal/editorjs/support/domain"
 	"reflect"
 	"strings"
 )
 
+var errEmptyStyleMap = errors.New("editorjs: style map is empty")
+
+// Parser renders the document within support.DefaultLimits. Errors are
+// logged and give an empty string; ParserWithLimits returns them.
 func Parser(jsonstr, styleName string) string {
+	htmlStr, err := ParserWithLimits(jsonstr, styleName, support.DefaultLimits)
+	if err != nil {
+		log.Println("Error parsing the input json\n", err)
+	}
+
+	return htmlStr
+}
 
-	useDefault := true
-	if styleName == "custom" {
-		useDefault = false
-		styleName = support.SM.StyleName
+func ParserWithLimits(jsonstr, styleName string, limits support.Limits) (string, error) {
+	f, err := render(jsonstr, styleName, limits, false)
+	if err != nil {
+		return "", err
 	}
 
-	var f domain.EditorJSMethods
-	switch styleName {
-	case sample.StyleName:
-		samplePkg := sample.Init(useDefault)
-		f = &samplePkg
-	case bootstrap.StyleName:
-		bootstrapPkg := bootstrap.Init(useDefault)
-		f = &bootstrapPkg
-	case bulma.StyleName:
-		bulmaPkg := bulma.Init(useDefault)
-		f = &bulmaPkg
+	return f.GetHtml(), nil
+}
+
+func render(jsonstr, styleName string, limits support.Limits, selfContained bool) (domain.EditorJSMethods, error) {
+
+	f, err := rendererFor(styleName)
+	if err != nil {
+		return nil, err
 	}
 
+	if err = renderPage(f, jsonstr, limits, selfContained); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// renderPage renders the document into f, which rendererFor returned.
+func renderPage(f domain.EditorJSMethods, jsonstr string, limits support.Limits, selfContained bool) error {
 	if reflect.DeepEqual(support.SM, domain.StyleMap{}) {
-		log.Fatal("Style map is empty\n", nil)
+		return errEmptyStyleMap
 	}
 
-	if !support.IsValidStyle(support.SM.StyleName) {
-		log.Fatal("Invalid style name: "+support.SM.StyleName+"\n", nil)
+	if selfContained {
+		if err := loadLocalLibrary(f); err != nil {
+			return err
+		}
+	} else {
+		f.LoadLibrary()
 	}
 
-	f.LoadLibrary()
+	templates, err := parseTemplates(support.SM.Templates)
+	if err != nil {
+		return err
+	}
 
-	//input, err := support.ReadJsonFile(jsonFilePath)
-	//if err != nil {
-	//	log.Println("It was not possible to read the input json file\n", err)
-	//}
+	editorJSON, err := support.ParseEditorJSONWithLimits(jsonstr, limits)
+	if err != nil {
+		return err
+	}
 
-	editorJSON := support.ParseEditorJSON(jsonstr)
+	if err = renderBlocks(f, editorJSON.Blocks, templates, limits, map[string]bool{}); err != nil {
+		return err
+	}
+
+	f.Separator()
 
-	for _, el := range editorJSON.Blocks {
+	return nil
+}
+
+// renderBlocks renders blocks into f. The library assets of each block are
+// added before the block, so inline block scripts run after them.
+func renderBlocks(f domain.EditorJSMethods, blocks []domain.EditorJSBlock, templates map[string]*template.Template, limits support.Limits, seenLibs map[string]bool) (err error) {
+	for _, el := range blocks {
+
+		if err = support.CheckBlockLimits(el, limits); err != nil {
+			return err
+		}
 
-		styles, scripts := appendLibs(el)
+		styles, scripts := appendLibs(el, seenLibs)
 		f.SetStyles(styles)
 		f.SetScripts(scripts)
-		//f.Separator()
-		f.SetData(support.PrepareData(el))
-
-		switch el.Type {
-
-		case "header":
-			f.Header()
-		case "paragraph":
-			f.Paragraph()
-		case "quote":
-			f.Quote()
-		case "warning":
-			f.Warning()
-		case "delimiter":
-			f.Delimiter()
-		case "alert":
-			f.Alert()
-		case "list":
-			f.List()
-		case "checklist":
-			f.Checklist()
-		case "table":
-			f.Table()
-		case "AnyButton":
-			f.AnyButton()
-		case "code":
-			f.Code()
-		case "raw":
-			f.Raw()
-		case "image":
-			f.Image()
-		case "linkTool":
-			f.LinkTool()
-		case "attaches":
-			f.Attaches()
-		case "embed":
-			f.Embed()
-		case "imageGallery":
-			f.ImageGallery()
+		data := support.PrepareData(el)
+		f.SetData(data)
+
+		if tmpl, ok := templates[el.Type]; ok {
+			blockHtml, err := executeTemplate(tmpl, el, data)
+			if err != nil {
+				return err
+			}
+			f.SetResult(blockHtml)
+			continue
 		}
 
+		if _, _, err = support.RenderBlock(el.Type, data, pageBlocks{f}); err != nil {
+			return err
+		}
 	}
 
-	f.Separator()
-	htmlStr := f.GetHtml()
+	return nil
+}
 
-	//err = support.WriteOutputFile(outputFilePath, f.CreatePage(), "html")
-	//if err != nil {
-	//	log.Println("It was not possible to write the output html file\n", err)
-	//}
+// pageBlocks lets support.RenderBlock drive a page renderer, which reads the
+// block from the data set on it and collects the output itself.
+type pageBlocks struct {
+	f domain.EditorJSMethods
+}
 
-	return htmlStr
+func (p pageBlocks) Header(*domain.EditorJSDataHeader) string       { p.f.Header(); return "" }
+func (p pageBlocks) Paragraph(*domain.EditorJSDataParagraph) string { p.f.Paragraph(); return "" }
+func (p pageBlocks) Quote(*domain.EditorJSDataQuote) string         { p.f.Quote(); return "" }
+func (p pageBlocks) Warning(*domain.EditorJSDataWarning) string     { p.f.Warning(); return "" }
+func (p pageBlocks) Delimiter() string                              { p.f.Delimiter(); return "" }
+func (p pageBlocks) Alert(*domain.EditorJSDataAlert) string         { p.f.Alert(); return "" }
+func (p pageBlocks) List(*domain.EditorJSDataList) (string, error)  { return "", p.f.List() }
+func (p pageBlocks) Checklist(*domain.EditorJSDataChecklist) string { p.f.Checklist(); return "" }
+func (p pageBlocks) Table(*domain.EditorJSDataTable) string         { p.f.Table(); return "" }
+func (p pageBlocks) AnyButton(*domain.EditorJSDataAnyButton) string { p.f.AnyButton(); return "" }
+func (p pageBlocks) Code(*domain.EditorJSDataCode) string           { p.f.Code(); return "" }
+func (p pageBlocks) Raw(*domain.EditorJSDataRaw) string             { p.f.Raw(); return "" }
+func (p pageBlocks) Image(*domain.EditorJSDataImage) string         { p.f.Image(); return "" }
+func (p pageBlocks) LinkTool(*domain.EditorJSDataLinkTool) string   { p.f.LinkTool(); return "" }
+func (p pageBlocks) Attaches(*domain.EditorJSDataAttaches) string   { p.f.Attaches(); return "" }
+func (p pageBlocks) Embed(*domain.EditorJSDataEmbed) string         { p.f.Embed(); return "" }
+func (p pageBlocks) ImageGallery(*domain.EditorJSDataImageGallery) string {
+	p.f.ImageGallery()
+	return ""
 }
 
-func appendLibs(block domain.EditorJSBlock) (styles []string, scripts []string) {
+// appendLibs returns the library assets of a block type the first time the
+// type is seen in a render, so repeated blocks share one copy.
+func appendLibs(block domain.EditorJSBlock, seen map[string]bool) (styles []string, scripts []string) {
 	libName := strings.ToLower(block.Type)
-	libPath := config.LibsPath + libName + "/"
-	if _, err := os.Stat(libPath); !os.IsNotExist(err) {
+	if seen[libName] {
+		return
+	}
+	seen[libName] = true
+
+	if support.HasLib(libName) {
 		styleMinified := string(support.MinifyLib(libName+"/"+libName+".css", "css"))
 		if styleMinified != "" {
 			styles = append(styles, `<style>`+styleMinified+`</style>`)
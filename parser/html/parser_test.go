@@ -0,0 +1,76 @@
+package html
+
+import (
+	"errors"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/matryer/is"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func manyBlocksInput(n int) string {
+	var blocks []string
+
+	for i := 0; i < n; i++ {
+		blocks = append(blocks, `{"type": "paragraph", "data": {"text": "Paragraph `+strconv.Itoa(i)+`"}}`,
+			`{"type": "imageGallery", "data": {"layoutDefault": true, "urls": ["https://example.com/`+strconv.Itoa(i)+`.jpg"]}}`)
+	}
+
+	return `{"blocks": [` + strings.Join(blocks, ",") + `]}`
+}
+
+func TestLibsAreDeduplicated(t *testing.T) {
+	is := is.New(t)
+
+	body, err := ParserWithLimits(manyBlocksInput(5), "bootstrap", support.DefaultLimits)
+	is.NoErr(err)
+	is.Equal(strings.Count(body, `class="gg-container`), 5)
+
+	page, err := Page(manyBlocksInput(5), "bootstrap", PageOptions{Limits: support.DefaultLimits})
+	is.NoErr(err)
+
+	is.Equal(strings.Count(page, "<style>.gg-container"), 1) // gallery styles are only included once
+	is.Equal(strings.Count(page, "class GalleryGrid"), 1)    // so is the library script
+
+	library := strings.Index(page, "class GalleryGrid")
+	block := strings.Index(page, "const gg=new GalleryGrid")
+	is.True(library >= 0 && block >= 0)
+	is.True(library < block) // the library is defined before the first block uses it
+}
+
+func TestParserReportsErrors(t *testing.T) {
+	is := is.New(t)
+
+	oversized := manyBlocksInput(support.DefaultLimits.MaxBlocks)
+
+	_, err := ParserWithLimits(oversized, "bootstrap", support.DefaultLimits)
+	is.True(errors.Is(err, support.ErrLimitExceeded))
+
+	is.Equal(Parser(oversized, "bootstrap"), "") // the error is logged instead of ending the process
+	is.Equal(Parser(`{"blocks": {}}`, "bootstrap"), "")
+}
+
+func BenchmarkParserManyBlocks(b *testing.B) {
+	input := manyBlocksInput(200)
+	support.SM = domain.StyleMap{}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := ParserWithLimits(input, "bootstrap", support.Limits{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMinifyLib(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		support.MinifyLib("imagegallery/imagegallery.css", "css")
+		support.MinifyLib("imagegallery/imagegallery.js", "js")
+	}
+}
@@ -97,9 +97,15 @@ func (o *Object) Alert() {
 	o.Result = append(o.Result, common.Alert(obj))
 }
 
-func (o *Object) List() {
+func (o *Object) List() error {
 	obj := o.Data.(*domain.EditorJSDataList)
-	o.Result = append(o.Result, common.List(obj))
+	output, err := common.List(obj)
+	if err != nil {
+		return err
+	}
+
+	o.Result = append(o.Result, output)
+	return nil
 }
 
 func (o *Object) Checklist() {
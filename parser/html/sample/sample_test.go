@@ -271,7 +271,7 @@ func TestListBlock(t *testing.T) {
 <li class="list_item">Simple and powerful API</li>
 </ul>`
 
-	obj.List()
+	is.NoErr(obj.List())
 
 	actual1 := obj.Result[0]
 
@@ -359,51 +359,44 @@ func TestListBlock(t *testing.T) {
 	obj.Data = support.PrepareData(editorJSON2.Blocks[0])
 
 	expected2 := `<ol class="list_group">
-<li class="list_item">Cars</li>
+<li class="list_item">Cars
 <ol class="">
-<li class="list_item">BMW</li>
+<li class="list_item">BMW
 <ol class="">
 <li class="list_item">Z3</li>
-</li>
 <li class="list_item">Z4</li>
-</li>
 </ol>
 </li>
-<li class="list_item">Audi</li>
+<li class="list_item">Audi
 <ol class="">
 <li class="list_item">A3</li>
-</li>
 <li class="list_item">A1</li>
-</li>
 </ol>
 </li>
 </ol>
 </li>
-<li class="list_item">Motorcycle</li>
+<li class="list_item">Motorcycle
 <ol class="">
-<li class="list_item">Ducati</li>
+<li class="list_item">Ducati
 <ol class="">
 <li class="list_item">916</li>
-</li>
 </ol>
 </li>
-<li class="list_item">Yamanha</li>
+<li class="list_item">Yamanha
 <ol class="">
 <li class="list_item">DT 180</li>
-</li>
 </ol>
 </li>
-<li class="list_item">Honda</li>
+<li class="list_item">Honda
 <ol class="">
 <li class="list_item">VFR 750R</li>
-</li>
 </ol>
 </li>
 </ol>
 </li>
 </ol>`
 
-	obj.List()
+	is.NoErr(obj.List())
 
 	actual2 := obj.Result[0]
 
@@ -0,0 +1,397 @@
+package semantic
+
+import (
+	"github.com/banjuanshu/go-editorjs/parser/html/common"
+	sup "github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"html"
+	"strconv"
+	"strings"
+)
+
+type Object struct {
+	Data    interface{}
+	Result  []string
+	Styles  []string
+	Scripts []string
+}
+
+const (
+	StyleName = "semantic"
+	MapFile   = "semantic.json"
+)
+
+func Init(useDefaultMap bool) (framework Object) {
+	if useDefaultMap {
+		sup.LoadStyleMap(MapFile)
+	}
+	return framework
+}
+
+func (o *Object) SetData(data interface{}) {
+	o.Data = data
+}
+
+func (o *Object) SetStyles(styles []string) {
+	for _, style := range styles {
+		o.Styles = append(o.Styles, style)
+	}
+}
+
+func (o *Object) SetResult(result string) {
+	o.Result = append(o.Result, result)
+}
+
+func (o *Object) SetScripts(scripts []string) {
+	for _, script := range scripts {
+		o.Scripts = append(o.Scripts, script)
+	}
+}
+
+func (o *Object) LoadLibrary() {
+	for _, l := range sup.SM.LibraryPaths {
+		o.Styles = append(o.Styles, `<link rel="stylesheet" href="`+attr(l)+`" />`)
+	}
+}
+
+func (o *Object) CreatePage() string {
+	return common.CreatePage(o.Scripts, o.Styles, []string{o.GetHtml()})
+}
+
+func (o *Object) GetHtml() string {
+	return "<article>\n" + common.GetHtml(o.Result) + "\n</article>"
+}
+
+func (o *Object) Separator() {
+	if separator := common.Separator(); separator != "" {
+		o.SetResult(separator)
+	}
+}
+
+func (o *Object) Header() {
+	obj := o.Data.(*domain.EditorJSDataHeader)
+
+	level := obj.Level
+	if level < 1 || level > 6 {
+		level = 2
+	}
+
+	tag := `h` + strconv.Itoa(level)
+
+	id := ""
+	if obj.Anchor != "" {
+		id = ` id="` + attr(strings.ToLower(strings.ReplaceAll(obj.Anchor, " ", "-"))) + `"`
+	}
+
+	o.Result = append(o.Result, `<`+tag+id+class(sup.SM.Blocks.Header[tag])+`>`+obj.Text+`</`+tag+`>`)
+}
+
+func (o *Object) Paragraph() {
+	obj := o.Data.(*domain.EditorJSDataParagraph)
+	o.Result = append(o.Result, `<p`+aligned(sup.SM.Blocks.Paragraph, obj.Alignment)+`>`+obj.Text+`</p>`)
+}
+
+func (o *Object) Quote() {
+	obj := o.Data.(*domain.EditorJSDataQuote)
+	var output []string
+
+	output = append(output, `<figure`+aligned(sup.SM.Blocks.Quote.Figure, obj.Alignment)+`>`,
+		`<blockquote`+class(sup.SM.Blocks.Quote.Blockquote)+`>`,
+		`<p>`+obj.Text+`</p>`,
+		`</blockquote>`)
+
+	if obj.Caption != "" {
+		output = append(output, `<figcaption`+class(sup.SM.Blocks.Quote.Figcaption)+`>`+obj.Caption+`</figcaption>`)
+	}
+
+	output = append(output, `</figure>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Warning() {
+	obj := o.Data.(*domain.EditorJSDataWarning)
+	var output []string
+
+	output = append(output, `<aside role="note"`+class(sup.SM.Blocks.Warning.Block)+`>`)
+
+	if obj.Title != "" {
+		output = append(output, `<p><strong`+class(sup.SM.Blocks.Warning.Title)+`>`+obj.Title+`</strong></p>`)
+	}
+
+	output = append(output, `<p>`+obj.Message+`</p>`,
+		`</aside>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Delimiter() {
+	o.Result = append(o.Result, `<hr`+class(sup.SM.Blocks.Delimiter)+` />`)
+}
+
+func (o *Object) Alert() {
+	obj := o.Data.(*domain.EditorJSDataAlert)
+
+	alertType := ""
+	if obj.Type != "" {
+		alertType = ` data-type="` + attr(obj.Type) + `"`
+	}
+
+	o.Result = append(o.Result, strings.Join([]string{
+		`<aside role="alert"` + alertType + class(sup.SM.Blocks.Alert.Block+` `+sup.SM.Blocks.Alert.Types[obj.Type]) + `>`,
+		`<p>` + obj.Message + `</p>`,
+		`</aside>`,
+	}, "\n"))
+}
+
+func (o *Object) List() error {
+	obj := o.Data.(*domain.EditorJSDataList)
+
+	listStyle := "ol"
+	if obj.Style == "unordered" {
+		listStyle = "ul"
+	}
+
+	itemsList, err := sup.ListItems(obj.Items)
+	if err != nil {
+		return err
+	}
+
+	o.Result = append(o.Result, nestedList(itemsList, listStyle, sup.SM.Blocks.List.Group))
+	return nil
+}
+
+func nestedList(items []domain.NestedListItem, listStyle, group string) string {
+	var output []string
+
+	output = append(output, `<`+listStyle+class(group)+`>`)
+
+	for _, item := range items {
+		if len(item.Items) == 0 {
+			output = append(output, `<li`+class(sup.SM.Blocks.List.Item)+`>`+item.Content+`</li>`)
+			continue
+		}
+
+		output = append(output, `<li`+class(sup.SM.Blocks.List.Item)+`>`+item.Content,
+			nestedList(item.Items, listStyle, sup.SM.Blocks.List.NestedGroup),
+			`</li>`)
+	}
+
+	output = append(output, `</`+listStyle+`>`)
+
+	return strings.Join(output[:], "\n")
+}
+
+func (o *Object) Checklist() {
+	obj := o.Data.(*domain.EditorJSDataChecklist)
+	var output []string
+
+	output = append(output, `<ul`+class(sup.SM.Blocks.Checklist.Block)+`>`)
+
+	for _, item := range obj.Items {
+		checked := ""
+		if item.Checked {
+			checked = ` checked="checked"`
+		}
+
+		output = append(output, `<li`+class(sup.SM.Blocks.Checklist.Item)+`><label><input type="checkbox" disabled="disabled"`+checked+` /> `+item.Text+`</label></li>`)
+	}
+
+	output = append(output, `</ul>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Table() {
+	obj := o.Data.(*domain.EditorJSDataTable)
+	var output []string
+
+	output = append(output, `<table`+class(sup.SM.Blocks.Table.Table)+`>`)
+
+	rows := obj.Content
+	if obj.WithHeadings && len(rows) > 0 {
+		output = append(output, `<thead>`, tableRow(rows[0], "th", sup.SM.Blocks.Table.CellTH), `</thead>`)
+		rows = rows[1:]
+	}
+
+	if len(rows) > 0 {
+		output = append(output, `<tbody>`)
+		for _, row := range rows {
+			output = append(output, tableRow(row, "td", sup.SM.Blocks.Table.CellTD))
+		}
+		output = append(output, `</tbody>`)
+	}
+
+	output = append(output, `</table>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func tableRow(row []string, tag, cellClass string) string {
+	output := `<tr` + class(sup.SM.Blocks.Table.Row) + `>`
+
+	for _, info := range row {
+		output += `<` + tag + class(cellClass) + `>` + info + `</` + tag + `>`
+	}
+
+	return output + `</tr>`
+}
+
+func (o *Object) AnyButton() {
+	obj := o.Data.(*domain.EditorJSDataAnyButton)
+	o.Result = append(o.Result, `<p><a`+class(sup.SM.Blocks.AnyButton)+` href="`+attr(obj.Link)+`">`+obj.Text+`</a></p>`)
+}
+
+func (o *Object) Code() {
+	obj := o.Data.(*domain.EditorJSDataCode)
+
+	language := ""
+	if obj.LanguageCode != "" {
+		language = "language-" + obj.LanguageCode
+	}
+
+	o.Result = append(o.Result, `<pre`+class(sup.SM.Blocks.Code.Pre)+`><code`+class(sup.SM.Blocks.Code.Code+` `+language)+`>`+html.EscapeString(obj.Code)+`</code></pre>`)
+}
+
+func (o *Object) Raw() {
+	obj := o.Data.(*domain.EditorJSDataRaw)
+	o.Result = append(o.Result, `<pre`+class(sup.SM.Blocks.Raw.Pre)+`><code`+class(sup.SM.Blocks.Raw.Code)+`>`+html.EscapeString(obj.Html)+`</code></pre>`)
+}
+
+func (o *Object) Image() {
+	obj := o.Data.(*domain.EditorJSDataImage)
+
+	url := obj.URL
+	if obj.File.URL != "" {
+		url = obj.File.URL
+	}
+
+	var classes []string
+	if obj.WithBorder {
+		classes = append(classes, sup.SM.Blocks.Image.Border)
+	}
+	if obj.Stretched {
+		classes = append(classes, sup.SM.Blocks.Image.Stretched)
+	}
+	if obj.WithBackground {
+		classes = append(classes, sup.SM.Blocks.Image.Background)
+	}
+
+	var output []string
+
+	output = append(output, `<figure`+class(sup.SM.Blocks.Image.Block)+`>`,
+		`<img`+class(sup.SM.Blocks.Image.Image+` `+strings.Join(classes, " "))+` src="`+attr(url)+`" alt="`+attr(plainText(obj.Caption))+`" />`)
+
+	if obj.Caption != "" {
+		output = append(output, `<figcaption>`+obj.Caption+`</figcaption>`)
+	}
+
+	output = append(output, `</figure>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) LinkTool() {
+	obj := o.Data.(*domain.EditorJSDataLinkTool)
+	var output []string
+
+	title := obj.Meta.Title
+	if title == "" {
+		title = html.EscapeString(obj.Link)
+	}
+
+	output = append(output, `<article`+class(sup.SM.Blocks.LinkTool.Container)+`>`)
+
+	if obj.Meta.Image.URL != "" {
+		output = append(output, `<img`+class(sup.SM.Blocks.LinkTool.Image)+` src="`+attr(obj.Meta.Image.URL)+`" alt="" />`)
+	}
+
+	output = append(output, `<p><a`+class(sup.SM.Blocks.LinkTool.Link)+` href="`+attr(obj.Link)+`" rel="nofollow noopener noreferrer"><strong`+class(sup.SM.Blocks.LinkTool.Title)+`>`+title+`</strong></a></p>`)
+
+	if obj.Meta.Description != "" {
+		output = append(output, `<p`+class(sup.SM.Blocks.LinkTool.Description)+`>`+obj.Meta.Description+`</p>`)
+	}
+
+	output = append(output, `<p><small`+class(sup.SM.Blocks.LinkTool.LinkDescription)+`>`+html.EscapeString(strings.ReplaceAll(strings.ReplaceAll(obj.Link, "https://", ""), "http://", ""))+`</small></p>`,
+		`</article>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Attaches() {
+	obj := o.Data.(*domain.EditorJSDataAttaches)
+
+	name := obj.File.Name
+	if name == "" {
+		name = obj.Title
+	}
+
+	o.Result = append(o.Result, `<p`+class(sup.SM.Blocks.Attaches.Container)+`><a`+class(sup.SM.Blocks.Attaches.Link)+` href="`+attr(obj.File.URL)+`" download="`+attr(name)+`"><span`+class(sup.SM.Blocks.Attaches.Filename)+`>`+html.EscapeString(name)+`</span></a> <small`+class(sup.SM.Blocks.Attaches.Size)+`>`+sup.HumanFileSize(obj.File.Size)+`</small></p>`)
+}
+
+func (o *Object) Embed() {
+	obj := o.Data.(*domain.EditorJSDataEmbed)
+	var output []string
+
+	output = append(output, `<figure`+class(sup.SM.Blocks.Embed.Block)+`>`,
+		`<iframe src="`+attr(obj.Embed)+`" width="`+strconv.Itoa(obj.Width)+`" height="`+strconv.Itoa(obj.Height)+`" title="`+attr(plainText(obj.Caption))+`" allowfullscreen="allowfullscreen"></iframe>`,
+		`<figcaption`+class(sup.SM.Blocks.Embed.Title)+`>`)
+
+	if obj.Caption != "" {
+		output = append(output, obj.Caption+` `)
+	}
+
+	output = append(output, `<a`+class(sup.SM.Blocks.Embed.Link)+` href="`+attr(obj.Source)+`">Watch on `+html.EscapeString(obj.Service)+`</a>`,
+		`</figcaption>`,
+		`</figure>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) ImageGallery() {
+	obj := o.Data.(*domain.EditorJSDataImageGallery)
+	var output []string
+
+	output = append(output, `<figure>`)
+
+	for index, url := range obj.URLs {
+		output = append(output, `<img src="`+attr(url)+`" alt="Image `+strconv.Itoa(index+1)+`" />`)
+	}
+
+	output = append(output, `</figure>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+// class only renders the attribute when the style map has a class for the
+// element, so the default map produces markup without any class attributes.
+func class(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" {
+		return ""
+	}
+	return ` class="` + attr(name) + `"`
+}
+
+// aligned returns the class attribute for name, with the alignment class
+// from the style map added, or a text-align style when the map has none.
+func aligned(name, align string) string {
+	if c := sup.SM.Alignment[align]; c != "" {
+		return class(name + " " + c)
+	}
+
+	if align == "center" || align == "right" {
+		return class(name) + ` style="text-align: ` + align + `"`
+	}
+
+	return class(name)
+}
+
+func attr(value string) string {
+	return html.EscapeString(value)
+}
+
+func plainText(s string) string {
+	return inline.PlainText(inline.Parse(s))
+}
@@ -0,0 +1,286 @@
+package semantic
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+)
+
+var obj = Init(true)
+
+func TestHeaderBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "header",
+            "data": {
+                "level": 2,
+                "text": "Key Features",
+                "anchor": "Key Features"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataHeader)
+
+	expected := `<h2 id="key-features">Key Features</h2>`
+
+	obj.Header()
+
+	is.Equal(expected, obj.Result[0]) // Header is different from expected
+}
+
+func TestParagraphBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "paragraph",
+            "data": {
+                "text": "Centered <b>text</b>",
+                "alignment": "center"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataParagraph)
+
+	expected := `<p style="text-align: center">Centered <b>text</b></p>`
+
+	obj.Paragraph()
+
+	is.Equal(expected, obj.Result[0]) // Paragraph is different from expected
+}
+
+func TestAlignmentClasses(t *testing.T) {
+	is := is.New(t)
+
+	saved := support.SM
+	defer func() { support.SM = saved }()
+
+	support.SM = domain.StyleMap{Alignment: map[string]string{"center": "has-text-centered"}}
+	support.SM.Blocks.Paragraph = "lead"
+
+	obj.Result = []string{}
+	obj.Data = &domain.EditorJSDataParagraph{Text: "a", Alignment: "center"}
+	obj.Paragraph()
+
+	obj.Data = &domain.EditorJSDataParagraph{Text: "b", Alignment: "right"}
+	obj.Paragraph()
+
+	obj.Data = &domain.EditorJSDataQuote{Text: "c", Alignment: "center"}
+	obj.Quote()
+
+	is.Equal(obj.Result[0], `<p class="lead has-text-centered">a</p>`)         // alignment classes join the block class
+	is.Equal(obj.Result[1], `<p class="lead" style="text-align: right">b</p>`) // unmapped alignments become styles
+	is.True(strings.HasPrefix(obj.Result[2], `<figure class="has-text-centered">`))
+}
+
+func TestQuoteBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "quote",
+            "data": {
+                "text": "The unexamined life is not worth living.",
+                "caption": "Socrates"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataQuote)
+
+	expected := "<figure>\n<blockquote>\n<p>The unexamined life is not worth living.</p>\n</blockquote>\n<figcaption>Socrates</figcaption>\n</figure>"
+
+	obj.Quote()
+
+	is.Equal(expected, obj.Result[0]) // Quote is different from expected
+}
+
+func TestDelimiterBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+	obj.Delimiter()
+
+	is.Equal(`<hr />`, obj.Result[0]) // Delimiter is different from expected
+}
+
+func TestListBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "list",
+            "data": {
+                "style": "unordered",
+                "items": [
+                    {
+                        "content": "Apples",
+                        "items": [
+                            {
+                                "content": "Red",
+                                "items": []
+                            }
+                        ]
+                    },
+                    {
+                        "content": "Bananas",
+                        "items": []
+                    }
+                ]
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataList)
+
+	expected := "<ul>\n<li>Apples\n<ul>\n<li>Red</li>\n</ul>\n</li>\n<li>Bananas</li>\n</ul>"
+
+	is.NoErr(obj.List())
+
+	is.Equal(expected, obj.Result[0]) // List is different from expected
+}
+
+func TestChecklistBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "checklist",
+            "data": {
+                "items": [
+                    {
+                        "text": "Done",
+                        "checked": true
+                    },
+                    {
+                        "text": "Todo",
+                        "checked": false
+                    }
+                ]
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataChecklist)
+
+	expected := "<ul>\n" +
+		`<li><label><input type="checkbox" disabled="disabled" checked="checked" /> Done</label></li>` + "\n" +
+		`<li><label><input type="checkbox" disabled="disabled" /> Todo</label></li>` + "\n" +
+		"</ul>"
+
+	obj.Checklist()
+
+	is.Equal(expected, obj.Result[0]) // Checklist is different from expected
+}
+
+func TestTableBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "table",
+            "data": {
+                "withHeadings": true,
+                "content": [
+                    ["Kine", "Pigs"],
+                    ["1 pcs", "5 pcs"]
+                ]
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataTable)
+
+	expected := "<table>\n<thead>\n<tr><th>Kine</th><th>Pigs</th></tr>\n</thead>\n<tbody>\n<tr><td>1 pcs</td><td>5 pcs</td></tr>\n</tbody>\n</table>"
+
+	obj.Table()
+
+	is.Equal(expected, obj.Result[0]) // Table is different from expected
+}
+
+func TestCodeBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "code",
+            "data": {
+                "code": "if a < b && c > d {}",
+                "languageCode": "go"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataCode)
+
+	expected := `<pre><code class="language-go">if a &lt; b &amp;&amp; c &gt; d {}</code></pre>`
+
+	obj.Code()
+
+	is.Equal(expected, obj.Result[0]) // Code is different from expected
+}
+
+func TestImageBlock(t *testing.T) {
+	is := is.New(t)
+
+	input := `{
+    "blocks": [
+        {
+            "type": "image",
+            "data": {
+                "file": {
+                    "url": "https://example.com/cat.png"
+                },
+                "caption": "A <b>sleepy</b> cat"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Result = []string{}
+	obj.Data = support.PrepareData(editorJSON.Blocks[0]).(*domain.EditorJSDataImage)
+
+	expected := "<figure>\n" +
+		`<img src="https://example.com/cat.png" alt="A sleepy cat" />` + "\n" +
+		"<figcaption>A <b>sleepy</b> cat</figcaption>\n" +
+		"</figure>"
+
+	obj.Image()
+
+	is.Equal(expected, obj.Result[0]) // Image is different from expected
+}
@@ -0,0 +1,178 @@
+package html
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"html"
+	"reflect"
+	"strings"
+)
+
+const (
+	DefaultRevealURL   = "https://cdn.jsdelivr.net/npm/reveal.js@5"
+	DefaultRevealTheme = "white"
+	DefaultNotesTune   = "speakerNotes"
+)
+
+type SlideOptions struct {
+	// Limits bound the document, support.DefaultLimits when zero.
+	Limits support.Limits
+	// HeaderLevels start a new slide at headers of these levels. Delimiter
+	// blocks always start a new slide and aren't rendered.
+	HeaderLevels []int
+	// NotesTune is the block tune whose text becomes the speaker notes of
+	// the block's slide, DefaultNotesTune when empty.
+	NotesTune string
+	// RevealURL is the base URL of the reveal.js dist and plugin files,
+	// DefaultRevealURL when empty.
+	RevealURL string
+	// Theme is the reveal.js theme, DefaultRevealTheme when empty.
+	Theme string
+}
+
+// Slides renders the document as a reveal.js page, with each slide's blocks
+// rendered through the given style.
+func Slides(jsonstr, styleName string, options SlideOptions) (string, error) {
+	page, err := rendererFor(styleName)
+	if err != nil {
+		return "", err
+	}
+
+	if reflect.DeepEqual(support.SM, domain.StyleMap{}) {
+		return "", errEmptyStyleMap
+	}
+
+	limits := options.Limits
+	if limits == (support.Limits{}) {
+		limits = support.DefaultLimits
+	}
+
+	revealURL := strings.TrimRight(options.RevealURL, "/")
+	if revealURL == "" {
+		revealURL = DefaultRevealURL
+	}
+
+	theme := options.Theme
+	if theme == "" {
+		theme = DefaultRevealTheme
+	}
+
+	notesTune := options.NotesTune
+	if notesTune == "" {
+		notesTune = DefaultNotesTune
+	}
+
+	page.SetStyles([]string{
+		`<link rel="stylesheet" href="` + revealURL + `/dist/reveal.css">`,
+		`<link rel="stylesheet" href="` + revealURL + `/dist/theme/` + theme + `.css">`,
+	})
+	page.LoadLibrary()
+
+	templates, err := parseTemplates(support.SM.Templates)
+	if err != nil {
+		return "", err
+	}
+
+	editorJSON, err := support.ParseEditorJSONWithLimits(jsonstr, limits)
+	if err != nil {
+		return "", err
+	}
+
+	seenLibs := map[string]bool{}
+
+	// The slides are rendered into the page itself, so that library and
+	// block scripts keep their order; the sections are results of their own.
+	page.SetResult("<div class=\"reveal\">\n<div class=\"slides\">")
+
+	for _, slide := range splitSlides(editorJSON.Blocks, options.HeaderLevels) {
+		page.SetResult("<section>")
+
+		if err = renderBlocks(page, slide, templates, limits, seenLibs); err != nil {
+			return "", err
+		}
+
+		if notes := speakerNotes(slide, notesTune); notes != "" {
+			page.SetResult(`<aside class="notes">` + notes + `</aside>`)
+		}
+
+		page.SetResult("</section>")
+	}
+
+	page.SetResult(`</div>
+</div>
+<script src="` + revealURL + `/dist/reveal.js"></script>
+<script src="` + revealURL + `/plugin/notes/notes.js"></script>`)
+
+	page.SetScripts([]string{`Reveal.initialize({hash: true, plugins: [RevealNotes]});`})
+
+	return page.CreatePage(), nil
+}
+
+// splitSlides groups the blocks into slides, starting a new one at every
+// delimiter, which is dropped, and at headers of the given levels.
+func splitSlides(blocks []domain.EditorJSBlock, headerLevels []int) (slides [][]domain.EditorJSBlock) {
+	var slide []domain.EditorJSBlock
+
+	for _, el := range blocks {
+		switch {
+		case el.Type == "delimiter":
+			slides = append(slides, slide)
+			slide = nil
+			continue
+		case el.Type == "header" && len(slide) > 0 && startsSlide(el, headerLevels):
+			slides = append(slides, slide)
+			slide = nil
+		}
+
+		slide = append(slide, el)
+	}
+
+	slides = append(slides, slide)
+
+	// Delimiters at the start or end of the document, or next to each
+	// other, would leave empty slides.
+	var result [][]domain.EditorJSBlock
+	for _, s := range slides {
+		if len(s) > 0 {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func startsSlide(header domain.EditorJSBlock, levels []int) bool {
+	data, _ := header.Data.(map[string]interface{})
+	level, _ := data["level"].(float64)
+
+	for _, l := range levels {
+		if float64(l) == level {
+			return true
+		}
+	}
+
+	return false
+}
+
+// speakerNotes joins the notes tunes of the slide's blocks. A tune is either
+// the text itself or an object with a text field; the text is escaped.
+func speakerNotes(slide []domain.EditorJSBlock, tune string) string {
+	var notes []string
+
+	for _, el := range slide {
+		var text string
+
+		switch v := el.Tunes[tune].(type) {
+		case string:
+			text = v
+		case map[string]interface{}:
+			text, _ = v["text"].(string)
+		}
+
+		if text = strings.TrimSpace(text); text != "" {
+			notes = append(notes, html.EscapeString(text))
+		}
+	}
+
+	return strings.Join(notes, "<br>")
+}
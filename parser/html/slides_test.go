@@ -0,0 +1,86 @@
+package html
+
+import (
+	"errors"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+)
+
+const slidesInput = `{
+    "blocks": [
+        {"type": "delimiter", "data": {}},
+        {"type": "header", "data": {"text": "Intro", "level": 1}, "tunes": {"speakerNotes": "Say hello & smile"}},
+        {"type": "paragraph", "data": {"text": "First"}, "tunes": {"speakerNotes": {"text": "Then the agenda"}}},
+        {"type": "header", "data": {"text": "Details", "level": 3}},
+        {"type": "paragraph", "data": {"text": "Second"}},
+        {"type": "delimiter", "data": {}},
+        {"type": "header", "data": {"text": "Part two", "level": 2}},
+        {"type": "imageGallery", "data": {"layoutDefault": true, "urls": ["https://example.com/1.jpg"]}},
+        {"type": "header", "data": {"text": "Outro", "level": 2}}
+    ]
+}`
+
+func TestSplitSlides(t *testing.T) {
+	is := is.New(t)
+
+	editorJSON := support.ParseEditorJSON(slidesInput)
+
+	is.Equal(len(splitSlides(editorJSON.Blocks, nil)), 2)                   // only delimiters, the leading one adds no slide
+	is.Equal(len(splitSlides(editorJSON.Blocks, []int{2})), 3)              // the first header after a delimiter doesn't split again
+	is.Equal(len(splitSlides(editorJSON.Blocks, []int{1, 2, 3})), 4)        // Intro, Details, Part two, Outro
+	is.Equal(len(splitSlides(editorJSON.Blocks, []int{1, 2, 3})[1]), 2)     // Details and its paragraph
+	is.Equal(splitSlides(editorJSON.Blocks, []int{2})[1][0].Type, "header") // delimiters are dropped
+}
+
+func TestSlides(t *testing.T) {
+	is := is.New(t)
+
+	page, err := Slides(slidesInput, "bootstrap", SlideOptions{HeaderLevels: []int{2}})
+	is.NoErr(err)
+
+	is.Equal(strings.Count(page, "<section>"), 3)
+	is.True(strings.Contains(page, `<div class="reveal">`))
+	is.True(strings.Contains(page, `<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@5/dist/theme/white.css">`))
+	is.True(strings.Contains(page, `Reveal.initialize({hash: true, plugins: [RevealNotes]});`))
+	is.True(strings.Contains(page, `<aside class="notes">Say hello &amp; smile<br>Then the agenda</aside>`)) // notes of all the slide's blocks, escaped
+	is.Equal(strings.Count(page, "<style>.gg-container"), 1)                                                 // block libraries are added to the page
+}
+
+func TestSlidesNotesTune(t *testing.T) {
+	is := is.New(t)
+
+	input := `{"blocks": [{"type": "paragraph", "data": {"text": "Hi"}, "tunes": {"notes": "Custom", "speakerNotes": "Default"}}]}`
+
+	page, err := Slides(input, "bootstrap", SlideOptions{NotesTune: "notes", RevealURL: "/reveal/", Theme: "black"})
+	is.NoErr(err)
+
+	is.True(strings.Contains(page, `<aside class="notes">Custom</aside>`))
+	is.True(strings.Contains(page, `<script src="/reveal/dist/reveal.js"></script>`))
+	is.True(strings.Contains(page, `href="/reveal/dist/theme/black.css"`))
+}
+
+func TestSlidesGalleryScripts(t *testing.T) {
+	is := is.New(t)
+
+	page, err := Slides(slidesInput, "bootstrap", SlideOptions{HeaderLevels: []int{2}})
+	is.NoErr(err)
+
+	class := strings.Index(page, "class GalleryGrid")
+	use := strings.Index(page, "const gg=new GalleryGrid")
+
+	is.True(class >= 0 && use >= 0)                         // the slide's gallery keeps its scripts
+	is.True(class < use)                                    // the library is defined before the gallery uses it
+	is.True(use < strings.Index(page, "Reveal.initialize")) // reveal.js starts after the block scripts
+}
+
+func TestSlidesDefaultLimits(t *testing.T) {
+	is := is.New(t)
+
+	_, err := Slides(manyBlocksInput(support.DefaultLimits.MaxBlocks), "sample", SlideOptions{})
+	is.True(errors.Is(err, support.ErrLimitExceeded)) // zero limits mean the default ones
+
+	_, err = Slides(manyBlocksInput(support.DefaultLimits.MaxBlocks), "sample", SlideOptions{Limits: support.Limits{MaxBlocks: 1 << 30}})
+	is.NoErr(err)
+}
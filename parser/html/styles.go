@@ -0,0 +1,83 @@
+package html
+
+import (
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/parser/html/bootstrap"
+	"github.com/banjuanshu/go-editorjs/parser/html/bulma"
+	"github.com/banjuanshu/go-editorjs/parser/html/email"
+	"github.com/banjuanshu/go-editorjs/parser/html/sample"
+	"github.com/banjuanshu/go-editorjs/parser/html/semantic"
+	"github.com/banjuanshu/go-editorjs/parser/html/tailwind"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+)
+
+func init() {
+	mustRegister(sample.StyleName, sample.MapFile, func() domain.EditorJSMethods {
+		samplePkg := sample.Init(false)
+		return &samplePkg
+	})
+	mustRegister(bootstrap.StyleName, bootstrap.MapFile, func() domain.EditorJSMethods {
+		bootstrapPkg := bootstrap.Init(false)
+		return &bootstrapPkg
+	})
+	mustRegister(bulma.StyleName, bulma.MapFile, func() domain.EditorJSMethods {
+		bulmaPkg := bulma.Init(false)
+		return &bulmaPkg
+	})
+	mustRegister(tailwind.StyleName, tailwind.MapFile, func() domain.EditorJSMethods {
+		tailwindPkg := tailwind.Init(false)
+		return &tailwindPkg
+	})
+	mustRegister(tailwind.ProseStyleName, tailwind.ProseMapFile, func() domain.EditorJSMethods {
+		tailwindPkg := tailwind.InitProse(false)
+		return &tailwindPkg
+	})
+	mustRegister(email.StyleName, email.MapFile, func() domain.EditorJSMethods {
+		emailPkg := email.Init(false)
+		return &emailPkg
+	})
+	mustRegister(semantic.StyleName, semantic.MapFile, genericRenderer)
+}
+
+// mustRegister registers a built-in style from its embedded map; a broken
+// embedded map is a bug in the package, so it panics.
+func mustRegister(name, mapFile string, renderer support.RendererFactory) {
+	styleMap, err := support.StyleMapAsset(mapFile)
+	if err == nil {
+		err = support.RegisterStyle(name, styleMap, renderer)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// genericRenderer renders maps registered without a renderer of their own and
+// custom maps whose styleName isn't registered.
+func genericRenderer() domain.EditorJSMethods {
+	semanticPkg := semantic.Init(false)
+	return &semanticPkg
+}
+
+func rendererFor(styleName string) (f domain.EditorJSMethods, err error) {
+	useDefault := true
+	if styleName == "custom" {
+		useDefault = false
+		styleName = support.SM.StyleName
+	}
+
+	style, ok := support.LookupStyle(styleName)
+
+	switch {
+	case useDefault && !ok:
+		return nil, fmt.Errorf("editorjs: invalid style name: %s", styleName)
+	case useDefault:
+		support.SM = style.Map
+	}
+
+	if !ok || style.Renderer == nil {
+		return genericRenderer(), nil
+	}
+
+	return style.Renderer(), nil
+}
@@ -0,0 +1,58 @@
+package html
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/config"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/matryer/is"
+	"testing"
+)
+
+const paragraphInput = `{
+    "blocks": [
+        {
+            "type": "paragraph",
+            "data": {
+                "text": "Registered"
+            }
+        }
+    ]
+}`
+
+func TestRegisterStyle(t *testing.T) {
+	is := is.New(t)
+
+	brand := domain.StyleMap{Blocks: domain.Blocks{Paragraph: "brand-text"}}
+	is.NoErr(support.RegisterStyle("brand", brand, nil))
+	is.True(support.RegisterStyle("", brand, nil) != nil) // Empty names are an error
+
+	is.True(support.IsValidStyle("brand")) // Registered style should be valid
+
+	found := false
+	for _, name := range support.Styles() {
+		found = found || name == "brand"
+	}
+	is.True(found)                                       // Registered style should be listed
+	is.Equal(config.AvailableStyles(), support.Styles()) // The deprecated list follows the registry
+
+	actual, err := ParserWithLimits(paragraphInput, "brand", support.Limits{})
+	is.NoErr(err)
+	is.Equal(actual, "<article>\n"+`<p class="brand-text">Registered</p>`+"\n</article>") // Registered style is different from expected
+}
+
+func TestCustomStyleName(t *testing.T) {
+	is := is.New(t)
+
+	support.SM = domain.StyleMap{StyleName: "my-site", Blocks: domain.Blocks{Paragraph: "lead"}}
+
+	actual, err := ParserWithLimits(paragraphInput, "custom", support.Limits{})
+	is.NoErr(err)
+	is.Equal(actual, "<article>\n"+`<p class="lead">Registered</p>`+"\n</article>") // Custom map is different from expected
+}
+
+func TestUnknownStyle(t *testing.T) {
+	is := is.New(t)
+
+	_, err := ParserWithLimits(paragraphInput, "missing", support.Limits{})
+	is.True(err != nil) // Unknown style should return an error
+}
@@ -0,0 +1,304 @@
+package tailwind
+
+import (
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/parser/html/common"
+	sup "github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/config"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"regexp"
+	"strings"
+)
+
+type Object struct {
+	Data    interface{}
+	Result  []string
+	Styles  []string
+	Scripts []string
+}
+
+const (
+	StyleName      = "tailwind"
+	ProseStyleName = "tailwind-prose"
+	MapFile        = "tailwind.json"
+	ProseMapFile   = "tailwind-prose.json"
+	ScriptFile     = "tailwind.js"
+	ScriptType     = "js"
+)
+
+const closeButton = `<button type="button" class="absolute right-3 top-2 text-xl leading-none opacity-60 hover:opacity-100" data-dismiss="alert" aria-label="Close">&times;</button>`
+
+func Init(useDefaultMap bool) (framework Object) {
+	if useDefaultMap {
+		sup.LoadStyleMap(MapFile)
+	}
+	return framework
+}
+
+// InitProse loads the map meant for the typography plugin, where the output
+// is wrapped in a prose container and most blocks are left unstyled.
+func InitProse(useDefaultMap bool) (framework Object) {
+	if useDefaultMap {
+		sup.LoadStyleMap(ProseMapFile)
+	}
+	return framework
+}
+
+func (o *Object) SetData(data interface{}) {
+	o.Data = data
+}
+
+func (o *Object) SetStyles(styles []string) {
+	for _, style := range styles {
+		o.Styles = append(o.Styles, style)
+	}
+}
+
+func (o *Object) SetResult(result string) {
+	o.Result = append(o.Result, result)
+}
+
+func (o *Object) SetScripts(scripts []string) {
+	for _, script := range scripts {
+		o.Scripts = append(o.Scripts, script)
+	}
+}
+
+func (o *Object) LoadLibrary() {
+	for _, l := range sup.SM.LibraryPaths {
+		o.Styles = append(o.Styles, `<script src="`+l+`"></script>`)
+	}
+
+	o.Scripts = append(o.Scripts, string(sup.MinifyAsset(config.AssetsScriptPath+ScriptFile, ScriptType)))
+}
+
+func (o *Object) CreatePage() string {
+	return common.CreatePage(o.Scripts, o.Styles, o.container())
+}
+
+func (o *Object) GetHtml() string {
+	return common.GetHtml(o.container())
+}
+
+func (o *Object) container() []string {
+	if sup.SM.Container == "" {
+		return o.Result
+	}
+
+	return []string{`<article class="` + sup.SM.Container + `">` + "\n" + common.GetHtml(o.Result) + "\n" + `</article>`}
+}
+
+func (o *Object) Separator() {
+	o.SetResult(common.Separator())
+}
+
+func (o *Object) Header() {
+	obj := o.Data.(*domain.EditorJSDataHeader)
+	o.Result = append(o.Result, classes(common.Header(obj)))
+}
+
+func (o *Object) Paragraph() {
+	obj := o.Data.(*domain.EditorJSDataParagraph)
+	o.Result = append(o.Result, classes(common.Paragraph(obj)))
+}
+
+func (o *Object) Quote() {
+	obj := o.Data.(*domain.EditorJSDataQuote)
+	o.Result = append(o.Result, classes(common.Quote(obj)))
+}
+
+func (o *Object) Warning() {
+	obj := o.Data.(*domain.EditorJSDataWarning)
+	var output []string
+
+	output = append(output, `<div`+class(sup.SM.Blocks.Warning.Block)+` role="alert">`)
+
+	if sup.SM.Blocks.Warning.CloseButton {
+		output = append(output, closeButton)
+	}
+
+	output = append(output, `<span`+class(sup.SM.Blocks.Warning.Title)+`>`,
+		obj.Title,
+		`</span>`,
+		obj.Message,
+		`</div>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Delimiter() {
+	o.Result = append(o.Result, classes(common.Delimiter()))
+}
+
+func (o *Object) Alert() {
+	obj := o.Data.(*domain.EditorJSDataAlert)
+	var output []string
+
+	output = append(output, `<div`+class(sup.SM.Blocks.Alert.Block+` `+sup.SM.Blocks.Alert.Types[obj.Type])+` role="alert">`)
+
+	if sup.SM.Blocks.Alert.CloseButton {
+		output = append(output, closeButton)
+	}
+
+	output = append(output, obj.Message,
+		`</div>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) List() error {
+	obj := o.Data.(*domain.EditorJSDataList)
+	output, err := common.List(obj)
+	if err != nil {
+		return err
+	}
+
+	// the preflight styles remove list markers, so they are restored here
+	output = strings.ReplaceAll(output, `<ul class="`, `<ul class="list-disc `)
+	output = strings.ReplaceAll(output, `<ol class="`, `<ol class="list-decimal `)
+
+	o.Result = append(o.Result, classes(output))
+	return nil
+}
+
+func (o *Object) Checklist() {
+	obj := o.Data.(*domain.EditorJSDataChecklist)
+	o.Result = append(o.Result, classes(common.Checklist(obj)))
+}
+
+func (o *Object) Table() {
+	obj := o.Data.(*domain.EditorJSDataTable)
+	o.Result = append(o.Result, classes(common.Table(obj)))
+}
+
+func (o *Object) AnyButton() {
+	obj := o.Data.(*domain.EditorJSDataAnyButton)
+	o.Result = append(o.Result, classes(common.AnyButton(obj)))
+}
+
+func (o *Object) Code() {
+	obj := o.Data.(*domain.EditorJSDataCode)
+	o.Result = append(o.Result, classes(common.Code(obj)))
+}
+
+func (o *Object) Raw() {
+	obj := o.Data.(*domain.EditorJSDataRaw)
+	o.Result = append(o.Result, classes(common.Raw(obj)))
+}
+
+func (o *Object) Image() {
+	obj := o.Data.(*domain.EditorJSDataImage)
+	classes := ""
+	classDiv := ""
+	url := ""
+
+	if obj.File.URL != "" {
+		url = obj.File.URL
+	} else {
+		url = obj.URL
+	}
+
+	if obj.WithBorder {
+		classes += sup.SM.Blocks.Image.Border + " "
+	}
+
+	if obj.Stretched {
+		classes += sup.SM.Blocks.Image.Stretched
+	}
+
+	if obj.WithBackground {
+		classDiv = sup.SM.Blocks.Image.Background
+	}
+
+	caption := ""
+	if obj.Caption != "" {
+		caption = fmt.Sprintf(`<figcaption%s>%s</figcaption>`, class(sup.SM.Blocks.Image.Caption), obj.Caption)
+	}
+
+	o.Result = append(o.Result, fmt.Sprintf(`<figure%s><img%s src="%s" alt="%s" title="%s" />%s</figure>`, class(sup.SM.Blocks.Image.Block+" "+classDiv), class(sup.SM.Blocks.Image.Image+" "+classes), url, obj.Caption, obj.Caption, caption))
+}
+
+func (o *Object) LinkTool() {
+	obj := o.Data.(*domain.EditorJSDataLinkTool)
+	var output []string
+
+	output = append(output, `<a href="`+obj.Link+`" target="_Blank" rel="nofollow noindex noreferrer"`+class(sup.SM.Blocks.LinkTool.Link)+`>`,
+		`<div`+class(sup.SM.Blocks.LinkTool.Container)+`>`,
+		`<div`+class(sup.SM.Blocks.LinkTool.LeftColumn)+`>`,
+		`<div`+class(sup.SM.Blocks.LinkTool.Title)+`>`,
+		obj.Meta.Title,
+		`</div>`,
+		`<div`+class(sup.SM.Blocks.LinkTool.Description)+`>`,
+		obj.Meta.Description,
+		`</div>`,
+		`<div`+class(sup.SM.Blocks.LinkTool.LinkDescription)+`>`,
+		strings.ReplaceAll(strings.ReplaceAll(obj.Link, "https://", ""), "http://", ""),
+		`</div>`,
+		`</div>`,
+		`<div`+class(sup.SM.Blocks.LinkTool.RightColumn)+`>`,
+		`<img`+class(sup.SM.Blocks.LinkTool.Image)+` src="`+obj.Meta.Image.URL+`" />`,
+		`</div>`,
+		`</div>`,
+		`</a>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Attaches() {
+	obj := o.Data.(*domain.EditorJSDataAttaches)
+	var output []string
+
+	output = append(output, `<a href="`+obj.File.URL+`" rel="noopener noreferrer" target="_blank"`+class(sup.SM.Blocks.Attaches.Link)+`>`,
+		`<div`+class(sup.SM.Blocks.Attaches.Container)+`>`,
+		`<div`+class(sup.SM.Blocks.Attaches.LeftColumn)+`>`,
+		`<img`+class(sup.SM.Blocks.Attaches.LeftImage)+` src="`+config.FileIconURL+`" />`,
+		`</div>`,
+		`<div`+class(sup.SM.Blocks.Attaches.CenterColumn)+`>`,
+		`<div`+class(sup.SM.Blocks.Attaches.Filename)+`>`,
+		obj.File.Name,
+		`</div>`,
+		`<div`+class(sup.SM.Blocks.Attaches.Size)+`>`,
+		sup.HumanFileSize(obj.File.Size),
+		`</div>`,
+		`</div>`,
+		`<div`+class(sup.SM.Blocks.Attaches.RightColumn)+`>`,
+		`<img`+class(sup.SM.Blocks.Attaches.RightImage)+` src="`+config.DownloadIconURL+`" />`,
+		`</div>`,
+		`</div>`,
+		`</a>`)
+
+	o.Result = append(o.Result, strings.Join(output[:], "\n"))
+}
+
+func (o *Object) Embed() {
+	obj := o.Data.(*domain.EditorJSDataEmbed)
+	o.Result = append(o.Result, classes(common.Embed(obj)))
+}
+
+func (o *Object) ImageGallery() {
+	obj := o.Data.(*domain.EditorJSDataImageGallery)
+	r, s := common.ImageGallery(obj)
+
+	o.Result = append(o.Result, classes(r))
+	o.Scripts = append(o.Scripts, sup.AppendBlockScript(s))
+}
+
+var classPattern = regexp.MustCompile(` class="([^"]*)"`)
+
+// class only renders the attribute when the style map has a class for the
+// element, since the prose map leaves most blocks to the typography plugin.
+func class(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" {
+		return ""
+	}
+	return ` class="` + name + `"`
+}
+
+// classes applies class to the attributes of the markup shared with the
+// other frameworks.
+func classes(output string) string {
+	return classPattern.ReplaceAllStringFunc(output, func(attr string) string {
+		return class(classPattern.FindStringSubmatch(attr)[1])
+	})
+}
@@ -0,0 +1,706 @@
+package tailwind
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/matryer/is"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var obj = Init(true)
+
+func TestHeaderBlock(t *testing.T) {
+	is := is.New(t)
+
+	classes := map[int]string{
+		1: "text-4xl font-extrabold tracking-tight text-gray-900 mb-4",
+		2: "text-3xl font-bold tracking-tight text-gray-900 mb-4",
+		3: "text-2xl font-bold text-gray-900 mb-3",
+		4: "text-xl font-semibold text-gray-900 mb-3",
+		5: "text-lg font-semibold text-gray-900 mb-2",
+		6: "text-base font-semibold text-gray-900 mb-2",
+	}
+
+	obj.Result = []string{}
+
+	for i := 1; i <= 6; i++ {
+		level := strconv.Itoa(i)
+
+		input1 := `{
+    "blocks": [
+        {
+            "type": "header",
+            "data": {
+                "level": ` + level + `,
+                "text": "Level ` + level + ` Header"
+            }
+        }
+    ]
+}`
+
+		editorJSON1 := support.ParseEditorJSON(input1)
+		obj.Data = support.PrepareData(editorJSON1.Blocks[0]).(*domain.EditorJSDataHeader)
+
+		expected1 := `<h` + level + `  class="` + classes[i] + `">Level ` + level + ` Header</h` + level + `>`
+
+		obj.Header()
+
+		actual1 := obj.Result[i-1]
+
+		is.Equal(expected1, actual1) // Header 1 is different from expected
+	}
+
+	obj.Result = []string{}
+
+	input2 := `{
+    "blocks": [
+        {
+            "type": "header",
+            "data": {
+                "level": 2,
+                "text": "Level 2 Header",
+                "anchor": "Anchor Text 2"
+            }
+        }
+    ]
+}`
+
+	editorJSON2 := support.ParseEditorJSON(input2)
+	obj.Data = support.PrepareData(editorJSON2.Blocks[0])
+
+	expected2 := `<h2 id="anchor-text-2" class="text-3xl font-bold tracking-tight text-gray-900 mb-4">Level 2 Header</h2>`
+
+	obj.Header()
+
+	actual2 := obj.Result[0]
+
+	is.Equal(expected2, actual2) // Header 2 is different from expected
+}
+
+func TestParagraphBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input1 := `{
+    "blocks": [
+        {
+			"type": "paragraph",
+            "data": {
+                "text": "I am a paragraph!"
+            }
+        }
+    ]
+}`
+
+	editorJSON1 := support.ParseEditorJSON(input1)
+	obj.Data = support.PrepareData(editorJSON1.Blocks[0])
+
+	expected1 := `<p class="text-base leading-7 text-gray-700 mb-4">I am a paragraph!</p>`
+
+	obj.Paragraph()
+
+	actual1 := obj.Result[0]
+
+	is.Equal(expected1, actual1) // Paragraph 1 is different from expected
+
+	obj.Result = []string{}
+
+	input2 := `{
+    "blocks": [
+        {
+			"type": "paragraph",
+            "data": {
+                "alignment": "center",
+                "text": "I am a paragraph!"
+            }
+        }
+    ]
+}`
+
+	editorJSON2 := support.ParseEditorJSON(input2)
+	obj.Data = support.PrepareData(editorJSON2.Blocks[0])
+
+	expected2 := `<p class="text-base leading-7 text-gray-700 mb-4 text-center">I am a paragraph!</p>`
+
+	obj.Paragraph()
+
+	actual2 := obj.Result[0]
+
+	is.Equal(expected2, actual2) // Paragraph 2 is different from expected
+}
+
+func TestQuoteBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input := `{
+    "blocks": [
+        {
+			"type": "quote",
+            "data": {
+                "alignment": "center",
+                "caption": "Lao Tzu",
+                "text": "The journey of a thousand miles begins with one step."
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `<figure class="my-6 text-center">
+<blockquote class="border-l-4 border-gray-300 pl-4 italic text-gray-700">
+The journey of a thousand miles begins with one step.
+</blockquote>
+<figcaption class="mt-2 pl-4 text-sm text-gray-500">
+Lao Tzu
+</figcaption>
+</figure>`
+
+	obj.Quote()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // Quote is different from expected
+}
+
+func TestWarningBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input := `{
+    "blocks": [
+        {
+			"type": "warning",
+            "data": {
+                "message": "Avoid using this method just for lulz. It can be very dangerous opposite your daily fun stuff.",
+                "title": "Note:"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `<div class="relative my-4 rounded-md border border-yellow-300 bg-yellow-50 p-4 text-yellow-800" role="alert">
+<button type="button" class="absolute right-3 top-2 text-xl leading-none opacity-60 hover:opacity-100" data-dismiss="alert" aria-label="Close">&times;</button>
+<span class="block font-semibold">
+Note:
+</span>
+Avoid using this method just for lulz. It can be very dangerous opposite your daily fun stuff.
+</div>`
+
+	obj.Warning()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // Warning is different from expected
+}
+
+func TestDelimiterBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	expected := `<div class="my-8 text-center text-2xl tracking-widest text-gray-400">***</div>`
+
+	obj.Delimiter()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // Delimiter is different from expected
+}
+
+func TestAlertBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input := `{
+    "blocks": [
+        {
+			"type": "alert",
+            "data": {
+                "message": "Something happened that you should know about.",
+                "type": "primary"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `<div class="relative my-4 rounded-md border p-4 border-blue-300 bg-blue-50 text-blue-800" role="alert">
+<button type="button" class="absolute right-3 top-2 text-xl leading-none opacity-60 hover:opacity-100" data-dismiss="alert" aria-label="Close">&times;</button>
+Something happened that you should know about.
+</div>`
+
+	obj.Alert()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // Alert is different from expected
+}
+
+func TestListBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input1 := `{
+    "blocks": [
+        {
+			"type": "list",
+            "data": {
+                "items": [
+                    "This is a block-styled editor",
+                    "Clean output data",
+                    "Simple and powerful API"
+                ],
+                "style": "unordered"
+            }
+        }
+    ]
+}`
+
+	editorJSON1 := support.ParseEditorJSON(input1)
+	obj.Data = support.PrepareData(editorJSON1.Blocks[0])
+
+	expected1 := `<ul class="list-disc my-4 ml-6 list-outside space-y-1 text-gray-700">
+<li class="leading-7">This is a block-styled editor</li>
+<li class="leading-7">Clean output data</li>
+<li class="leading-7">Simple and powerful API</li>
+</ul>`
+
+	is.NoErr(obj.List())
+
+	actual1 := obj.Result[0]
+
+	is.Equal(expected1, actual1) // List 1 is different from expected
+
+	obj.Result = []string{}
+
+	input2 := `{
+    "blocks": [
+        {
+			"type": "list",
+            "data": {
+                "items": [
+                    {
+                        "content": "Cars",
+                        "items": [
+                            {
+                                "content": "BMW",
+                                "items": [
+                                    {
+                                        "content": "Z3",
+                                        "items": []
+                                    },
+                                    {
+                                        "content": "Z4",
+                                        "items": []
+                                    }
+                                ]
+                            },
+                            {
+                                "content": "Audi",
+                                "items": [
+                                    {
+                                        "content": "A3",
+                                        "items": []
+                                    },
+                                    {
+                                        "content": "A1",
+                                        "items": []
+                                    }
+                                ]
+                            }
+                        ]
+                    },
+                    {
+                        "content": "Motorcycle",
+                        "items": [
+                            {
+                                "content": "Ducati",
+                                "items": [
+                                    {
+                                        "content": "916",
+                                        "items": []
+                                    }
+                                ]
+                            },
+                            {
+                                "content": "Yamanha",
+                                "items": [
+                                    {
+                                        "content": "DT 180",
+                                        "items": []
+                                    }
+                                ]
+                            },
+                            {
+                                "content": "Honda",
+                                "items": [
+                                    {
+                                        "content": "VFR 750R",
+                                        "items": []
+                                    }
+                                ]
+                            }
+                        ]
+                    }
+                ],
+                "style": "ordered"
+            }
+        }
+    ]
+}`
+
+	editorJSON2 := support.ParseEditorJSON(input2)
+	obj.Data = support.PrepareData(editorJSON2.Blocks[0])
+
+	expected2 := `<ol class="list-decimal my-4 ml-6 list-outside space-y-1 text-gray-700">
+<li class="leading-7">Cars
+<ol class="list-decimal ml-6 list-outside space-y-1">
+<li class="leading-7">BMW
+<ol class="list-decimal ml-6 list-outside space-y-1">
+<li class="leading-7">Z3</li>
+<li class="leading-7">Z4</li>
+</ol>
+</li>
+<li class="leading-7">Audi
+<ol class="list-decimal ml-6 list-outside space-y-1">
+<li class="leading-7">A3</li>
+<li class="leading-7">A1</li>
+</ol>
+</li>
+</ol>
+</li>
+<li class="leading-7">Motorcycle
+<ol class="list-decimal ml-6 list-outside space-y-1">
+<li class="leading-7">Ducati
+<ol class="list-decimal ml-6 list-outside space-y-1">
+<li class="leading-7">916</li>
+</ol>
+</li>
+<li class="leading-7">Yamanha
+<ol class="list-decimal ml-6 list-outside space-y-1">
+<li class="leading-7">DT 180</li>
+</ol>
+</li>
+<li class="leading-7">Honda
+<ol class="list-decimal ml-6 list-outside space-y-1">
+<li class="leading-7">VFR 750R</li>
+</ol>
+</li>
+</ol>
+</li>
+</ol>`
+
+	is.NoErr(obj.List())
+
+	actual2 := obj.Result[0]
+
+	is.Equal(expected2, actual2) // List 2 is different from expected
+}
+
+func TestTableBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input := `{
+    "blocks": [
+        {
+            "type": "table",
+            "data": {
+                "content": [
+                    [
+                        "Kine",
+                        "Pigs"
+                    ],
+                    [
+                        "1 pcs",
+                        "3 pcs"
+                    ]
+                ],
+                "withHeadings": true
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `<table class="my-6 w-full border-collapse text-left text-sm">
+<tr class="border-b border-gray-200">
+<th class="bg-gray-50 px-4 py-2 font-semibold text-gray-900">Kine</th>
+<th class="bg-gray-50 px-4 py-2 font-semibold text-gray-900">Pigs</th>
+</tr>
+<tr class="border-b border-gray-200">
+<td class="px-4 py-2 text-gray-700">1 pcs</td>
+<td class="px-4 py-2 text-gray-700">3 pcs</td>
+</tr>
+</table>`
+
+	obj.Table()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // Table is different from expected
+}
+
+func TestCodeBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input := `{
+    "blocks": [
+        {
+            "type": "code",
+            "data": {
+                "code": "body {\n font-size: 14px;\n line-height: 16px;\n}",
+                "languageCode": "css"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `<pre class="my-4 overflow-x-auto rounded-md bg-gray-900 p-4">
+<code class="font-mono text-sm text-gray-100">body {
+ font-size: 14px;
+ line-height: 16px;
+}
+</code></pre>`
+
+	obj.Code()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // Code is different from expected
+}
+
+func TestImageBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input1 := `{
+    "blocks": [
+        {
+		  "type" : "image",
+		  "data" : {
+			"url" : "https://images.freeimages.com/images/large-previews/2d8/mountains-1384887.jpg",
+			"caption" : "Mountain",
+			"withBorder" : true,
+			"withBackground" : true,
+			"stretched" : true
+		  }
+		}
+    ]
+}`
+
+	editorJSON1 := support.ParseEditorJSON(input1)
+	obj.Data = support.PrepareData(editorJSON1.Blocks[0])
+
+	expected1 := `<figure class="my-6 bg-gray-100 p-6"><img class="h-auto max-w-full border border-gray-300 w-full" src="https://images.freeimages.com/images/large-previews/2d8/mountains-1384887.jpg" alt="Mountain" title="Mountain" /><figcaption class="mt-2 text-center text-sm text-gray-500">Mountain</figcaption></figure>`
+
+	obj.Image()
+
+	actual1 := obj.Result[0]
+
+	is.Equal(expected1, actual1) // Image 1 is different from expected
+
+	obj.Result = []string{}
+
+	input2 := `{
+    "blocks": [
+        {
+		  "type" : "image",
+		  "data" : {
+			"file": {
+			  "url" : "https://images.freeimages.com/images/large-previews/2d8/mountains-1384887.jpg"
+			},
+			"caption" : "Mountain",
+			"withBorder" : false,
+			"withBackground" : true,
+			"stretched" : false
+		  }
+		}
+    ]
+}`
+
+	editorJSON2 := support.ParseEditorJSON(input2)
+	obj.Data = support.PrepareData(editorJSON2.Blocks[0])
+
+	expected2 := `<figure class="my-6 bg-gray-100 p-6"><img class="h-auto max-w-full" src="https://images.freeimages.com/images/large-previews/2d8/mountains-1384887.jpg" alt="Mountain" title="Mountain" /><figcaption class="mt-2 text-center text-sm text-gray-500">Mountain</figcaption></figure>`
+
+	obj.Image()
+
+	actual2 := obj.Result[0]
+
+	is.Equal(expected2, actual2) // Image 2 is different from expected
+
+	caption := support.SM.Blocks.Image.Caption
+	support.SM.Blocks.Image.Caption = "caption-brand"
+	defer func() { support.SM.Blocks.Image.Caption = caption }()
+
+	obj.Result = []string{}
+	obj.Image()
+
+	is.True(strings.Contains(obj.Result[0], `<figcaption class="caption-brand">Mountain</figcaption>`)) // Caption classes come from the style map
+}
+
+func TestLinkToolBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input := `{
+    "blocks": [
+        {
+		  "type" : "linkTool",
+		  "data" : {
+			"link" : "https://codex.so",
+			"meta" : {
+			  "title" : "CodeX Team",
+			  "site_name" : "CodeX",
+			  "description" : "Club of web-development, design and marketing. We build team learning how to build full-valued projects on the world market.",
+			  "image" : {
+				"url" : "https://pbs.twimg.com/profile_images/993612654861344768/wMPEM5XW_400x400.jpg"
+			  }
+			}
+		  }
+		}
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `<a href="https://codex.so" target="_Blank" rel="nofollow noindex noreferrer" class="block no-underline">
+<div class="my-4 flex overflow-hidden rounded-lg border border-gray-200 hover:bg-gray-50">
+<div class="flex flex-1 flex-col gap-1 p-4">
+<div class="font-semibold text-gray-900">
+CodeX Team
+</div>
+<div class="text-sm text-gray-600">
+Club of web-development, design and marketing. We build team learning how to build full-valued projects on the world market.
+</div>
+<div class="text-xs text-gray-400">
+codex.so
+</div>
+</div>
+<div class="w-32 shrink-0">
+<img class="h-full w-full object-cover" src="https://pbs.twimg.com/profile_images/993612654861344768/wMPEM5XW_400x400.jpg" />
+</div>
+</div>
+</a>`
+
+	obj.LinkTool()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // LinkTool is different from expected
+}
+
+func TestAttachesBlock(t *testing.T) {
+	is := is.New(t)
+
+	obj.Result = []string{}
+
+	input := `{
+    "blocks": [
+        {
+            "type": "attaches",
+            "data": {
+                "file": {
+                    "extension": "jpg",
+                    "name": "hero.jpg",
+                    "size": 260096,
+                    "url": "https://www.tesla.com/tesla_theme/assets/img/_vehicle_redesign/roadster_and_semi/roadster/hero.jpg"
+                },
+                "title": "Hero"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	obj.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `<a href="https://www.tesla.com/tesla_theme/assets/img/_vehicle_redesign/roadster_and_semi/roadster/hero.jpg" rel="noopener noreferrer" target="_blank" class="block no-underline">
+<div class="my-4 flex items-center gap-4 rounded-lg border border-gray-200 p-3 hover:bg-gray-50">
+<div class="shrink-0">
+<img class="h-10 w-10" src="https://i.ibb.co/K7Myr2k/file-icon.png" />
+</div>
+<div class="flex flex-1 flex-col">
+<div class="font-medium text-gray-900">
+hero.jpg
+</div>
+<div class="text-sm text-gray-500">
+254 KiB
+</div>
+</div>
+<div class="shrink-0">
+<img class="h-6 w-6" src="https://i.ibb.co/VYyHr6C/download-icon.png" />
+</div>
+</div>
+</a>`
+
+	obj.Attaches()
+
+	actual := obj.Result[0]
+
+	is.Equal(expected, actual) // Attaches is different from expected
+}
+
+func TestProseMode(t *testing.T) {
+	is := is.New(t)
+
+	prose := InitProse(true)
+	defer Init(true)
+
+	input := `{
+    "blocks": [
+        {
+			"type": "paragraph",
+            "data": {
+                "text": "I am a paragraph!"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	prose.Data = support.PrepareData(editorJSON.Blocks[0])
+
+	prose.Paragraph()
+
+	expected := `<article class="prose lg:prose-xl max-w-none">
+<p>I am a paragraph!</p>
+</article>`
+
+	actual := prose.GetHtml()
+
+	is.Equal(expected, actual) // Prose output is different from expected
+
+	prose.Result = []string{}
+	prose.Data = &domain.EditorJSDataTable{WithHeadings: true, Content: [][]string{{"Kine"}, {"1 pcs"}}}
+	prose.Table()
+
+	is.Equal(prose.Result[0], "<table>\n<tr>\n<th>Kine</th>\n</tr>\n<tr>\n<td>1 pcs</td>\n</tr>\n</table>") // empty classes are left out
+}
@@ -0,0 +1,60 @@
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"html/template"
+	"strings"
+)
+
+// TemplateData is the context a style map template is executed with. Data is
+// the decoded block (e.g. *domain.EditorJSDataLinkTool) and Classes the block
+// classes of the active style map.
+type TemplateData struct {
+	Type      string
+	Data      interface{}
+	Classes   domain.Blocks
+	Alignment map[string]string
+}
+
+var templateFuncs = template.FuncMap{
+	"raw": func(s string) template.HTML {
+		return template.HTML(s)
+	},
+	"fileSize": support.HumanFileSize,
+	"stripScheme": func(link string) string {
+		return strings.ReplaceAll(strings.ReplaceAll(link, "https://", ""), "http://", "")
+	},
+}
+
+func parseTemplates(sources map[string]string) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(sources))
+
+	for blockType, source := range sources {
+		tmpl, err := template.New(blockType).Funcs(templateFuncs).Parse(source)
+		if err != nil {
+			return nil, fmt.Errorf("editorjs: invalid %s template: %w", blockType, err)
+		}
+		templates[blockType] = tmpl
+	}
+
+	return templates, nil
+}
+
+func executeTemplate(tmpl *template.Template, block domain.EditorJSBlock, data interface{}) (string, error) {
+	var out bytes.Buffer
+
+	err := tmpl.Execute(&out, TemplateData{
+		Type:      block.Type,
+		Data:      data,
+		Classes:   support.SM.Blocks,
+		Alignment: support.SM.Alignment,
+	})
+	if err != nil {
+		return "", fmt.Errorf("editorjs: executing %s template: %w", block.Type, err)
+	}
+
+	return out.String(), nil
+}
@@ -0,0 +1,63 @@
+package html
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+)
+
+const templateInput = `{
+    "blocks": [
+        {
+            "type": "linkTool",
+            "data": {
+                "link": "https://codex.so",
+                "meta": {
+                    "title": "CodeX <b>Team</b>",
+                    "description": "Club of web-development, design and marketing"
+                }
+            }
+        },
+        {
+            "type": "paragraph",
+            "data": {
+                "text": "Plain paragraph"
+            }
+        }
+    ]
+}`
+
+func TestBlockTemplateOverride(t *testing.T) {
+	is := is.New(t)
+
+	support.LoadStyleMap("sample.json")
+	support.SM.Templates = map[string]string{
+		"linkTool":  `<a class="{{.Classes.LinkTool.Link}}" href="{{.Data.Link}}">{{raw .Data.Meta.Title}} ({{stripScheme .Data.Link}})</a>`,
+		"paragraph": `<p data-type="{{.Type}}">{{.Data.Text}}</p>`,
+	}
+
+	actual, err := ParserWithLimits(templateInput, "custom", support.Limits{})
+	is.NoErr(err)
+
+	expectedLink := `<a class="` + support.SM.Blocks.LinkTool.Link + `" href="https://codex.so">CodeX <b>Team</b> (codex.so)</a>`
+	expectedParagraph := `<p data-type="paragraph">Plain paragraph</p>`
+
+	is.True(strings.Contains(actual, expectedLink))      // LinkTool template was not applied
+	is.True(strings.Contains(actual, expectedParagraph)) // Paragraph template was not applied
+}
+
+func TestBlockTemplateError(t *testing.T) {
+	is := is.New(t)
+
+	support.LoadStyleMap("sample.json")
+	support.SM.Templates = map[string]string{
+		"linkTool": `{{.Data.Link`,
+	}
+
+	_, err := ParserWithLimits(templateInput, "custom", support.Limits{})
+	is.True(err != nil) // Invalid template should return an error
+
+	support.LoadStyleMap("sample.json")
+	is.Equal(len(support.SM.Templates), 0) // Reloading a style map should drop templates
+}
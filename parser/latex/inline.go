@@ -0,0 +1,116 @@
+package latex
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"strings"
+)
+
+type inlineRenderer struct{}
+
+// cellRenderer keeps table cells on one line, as l columns can't break.
+type cellRenderer struct {
+	inlineRenderer
+}
+
+// titleRenderer is used for the moving arguments of \section and \caption,
+// where the fragile ulem and soul commands fail, so their marks are dropped.
+type titleRenderer struct {
+	cellRenderer
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	"\u00a0", `~`,
+)
+
+// urlEscaper escapes the characters hyperref doesn't accept verbatim in the
+// first argument of \href.
+var urlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\~`,
+)
+
+// Escape protects LaTeX special characters in plain text.
+func Escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// Inline converts the inline HTML of Editor.js text into LaTeX.
+func Inline(s string) string {
+	return inline.Convert(s, inlineRenderer{})
+}
+
+func cell(s string) string {
+	return inline.Convert(s, cellRenderer{})
+}
+
+func title(s string) string {
+	return inline.Convert(s, titleRenderer{})
+}
+
+func (inlineRenderer) Text(text string) string {
+	return Escape(text)
+}
+
+func (inlineRenderer) Bold(inner string) string {
+	return `\textbf{` + inner + `}`
+}
+
+func (inlineRenderer) Italic(inner string) string {
+	return `\emph{` + inner + `}`
+}
+
+func (inlineRenderer) Underline(inner string) string {
+	return `\uline{` + inner + `}`
+}
+
+func (inlineRenderer) Strike(inner string) string {
+	return `\sout{` + inner + `}`
+}
+
+func (inlineRenderer) Code(text string) string {
+	return `\texttt{` + Escape(text) + `}`
+}
+
+func (inlineRenderer) Mark(inner string) string {
+	return `\hl{` + inner + `}`
+}
+
+func (inlineRenderer) Link(inner, href string) string {
+	return `\href{` + urlEscaper.Replace(href) + `}{` + inner + `}`
+}
+
+func (inlineRenderer) Break() string {
+	return "\\newline\n"
+}
+
+func (cellRenderer) Break() string {
+	return " "
+}
+
+func (titleRenderer) Underline(inner string) string {
+	return inner
+}
+
+func (titleRenderer) Strike(inner string) string {
+	return inner
+}
+
+func (titleRenderer) Mark(inner string) string {
+	return inner
+}
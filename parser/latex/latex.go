@@ -0,0 +1,312 @@
+package latex
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"strings"
+)
+
+type Renderer struct {
+	// Standalone wraps the output in a complete article with the packages the
+	// generated commands need.
+	Standalone bool
+}
+
+var sectionCommands = []string{`\section`, `\subsection`, `\subsubsection`, `\paragraph`, `\subparagraph`, `\subparagraph`}
+
+// listingsLanguages maps Editor.js language codes to the names listings
+// knows; other languages are typeset without highlighting.
+var listingsLanguages = map[string]string{
+	"bash":       "bash",
+	"c":          "C",
+	"cpp":        "C++",
+	"csharp":     "{[Sharp]C}",
+	"css":        "",
+	"html":       "HTML",
+	"java":       "Java",
+	"javascript": "",
+	"perl":       "Perl",
+	"php":        "PHP",
+	"python":     "Python",
+	"ruby":       "Ruby",
+	"sh":         "sh",
+	"shell":      "bash",
+	"sql":        "SQL",
+	"xml":        "XML",
+}
+
+func (r Renderer) Header(el *domain.EditorJSDataHeader) string {
+	level := el.Level
+	if level < 1 || level > len(sectionCommands) {
+		level = 2
+	}
+
+	header := sectionCommands[level-1] + `{` + title(el.Text) + `}`
+
+	if el.Anchor != "" {
+		header += `\label{` + label(el.Anchor) + `}`
+	}
+
+	return header
+}
+
+func (r Renderer) Paragraph(el *domain.EditorJSDataParagraph) string {
+	return align(Inline(el.Text), el.Alignment)
+}
+
+func (r Renderer) Quote(el *domain.EditorJSDataQuote) string {
+	var result []string
+
+	result = append(result, `\begin{quote}`, Inline(el.Text))
+
+	if el.Caption != "" {
+		result = append(result, `\par\hfill--- `+Inline(el.Caption))
+	}
+
+	result = append(result, `\end{quote}`)
+
+	return align(strings.Join(result, "\n"), el.Alignment)
+}
+
+func (r Renderer) Warning(el *domain.EditorJSDataWarning) string {
+	var result []string
+
+	result = append(result, `\begin{quote}`)
+
+	if el.Title != "" {
+		result = append(result, `\textbf{`+Inline(el.Title)+`}\par`)
+	}
+
+	result = append(result, Inline(el.Message), `\end{quote}`)
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Delimiter() string {
+	return "\\begin{center}\n\\rule{0.5\\linewidth}{0.4pt}\n\\end{center}"
+}
+
+func (r Renderer) Alert(el *domain.EditorJSDataAlert) string {
+	return "\\begin{quote}\n" + Inline(el.Message) + "\n\\end{quote}"
+}
+
+func (r Renderer) List(el *domain.EditorJSDataList) (string, error) {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return "", err
+	}
+
+	environment := "enumerate"
+	if el.Style == "unordered" {
+		environment = "itemize"
+	}
+
+	return nestedList(itemsList, environment, ""), nil
+}
+
+// nestedList indents nested environments by two spaces per level; LaTeX
+// supports four levels of itemize/enumerate nesting.
+func nestedList(items []domain.NestedListItem, environment, indent string) string {
+	var result []string
+
+	result = append(result, indent+`\begin{`+environment+`}`)
+
+	for _, item := range items {
+		result = append(result, indent+`  \item `+Inline(item.Content))
+
+		if len(item.Items) > 0 {
+			result = append(result, nestedList(item.Items, environment, indent+"  "))
+		}
+	}
+
+	result = append(result, indent+`\end{`+environment+`}`)
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Checklist(el *domain.EditorJSDataChecklist) string {
+	var result []string
+
+	result = append(result, `\begin{itemize}`)
+
+	for _, item := range el.Items {
+		box := `$\square$`
+		if item.Checked {
+			box = `$\boxtimes$`
+		}
+		result = append(result, `  \item[`+box+`] `+Inline(item.Text))
+	}
+
+	result = append(result, `\end{itemize}`)
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Table(el *domain.EditorJSDataTable) string {
+	columns := 0
+	for _, line := range el.Content {
+		if len(line) > columns {
+			columns = len(line)
+		}
+	}
+
+	if columns == 0 {
+		return ""
+	}
+
+	var result []string
+
+	result = append(result, `\begin{center}`, `\begin{tabular}{|`+strings.Repeat(`l|`, columns)+`}`, `\hline`)
+
+	for index, line := range el.Content {
+		var cells []string
+
+		for column := 0; column < columns; column++ {
+			info := ""
+			if column < len(line) {
+				info = cell(line[column])
+			}
+			if el.WithHeadings && index == 0 && info != "" {
+				info = `\textbf{` + info + `}`
+			}
+			cells = append(cells, info)
+		}
+
+		result = append(result, strings.Join(cells, ` & `)+` \\`, `\hline`)
+	}
+
+	result = append(result, `\end{tabular}`, `\end{center}`)
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return `\href{` + urlEscaper.Replace(el.Link) + `}{` + Inline(el.Text) + `}`
+}
+
+func (r Renderer) Code(el *domain.EditorJSDataCode) string {
+	if el.LanguageCode == "" {
+		return verbatim(el.Code)
+	}
+
+	options := ""
+	if language := listingsLanguages[strings.ToLower(el.LanguageCode)]; language != "" {
+		options = `[language=` + language + `]`
+	}
+
+	return `\begin{lstlisting}` + options + "\n" + strings.ReplaceAll(el.Code, `\end{lstlisting}`, `\end {lstlisting}`) + "\n" + `\end{lstlisting}`
+}
+
+func (r Renderer) Raw(el *domain.EditorJSDataRaw) string {
+	return verbatim(el.Html)
+}
+
+func (r Renderer) Image(el *domain.EditorJSDataImage) string {
+	url := el.URL
+	if el.File.URL != "" {
+		url = el.File.URL
+	}
+
+	// Remote images can't be included, so they are linked instead.
+	if remote(url) {
+		link := `\url{` + urlEscaper.Replace(url) + `}`
+		if el.Caption != "" {
+			link = `\href{` + urlEscaper.Replace(url) + `}{` + Inline(el.Caption) + `}`
+		}
+		return "\\begin{center}\n" + link + "\n\\end{center}"
+	}
+
+	var result []string
+
+	result = append(result, `\begin{figure}[h]`, `\centering`, `\includegraphics[width=\linewidth]{`+graphicsPath(url)+`}`)
+
+	if el.Caption != "" {
+		result = append(result, `\caption{`+title(el.Caption)+`}`)
+	}
+
+	result = append(result, `\end{figure}`)
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	title := Inline(el.Meta.Title)
+	if title == "" {
+		title = Escape(el.Link)
+	}
+
+	link := `\href{` + urlEscaper.Replace(el.Link) + `}{\textbf{` + title + `}}`
+
+	if el.Meta.Description != "" {
+		link += "\\par\n" + Inline(el.Meta.Description)
+	}
+
+	return link
+}
+
+func (r Renderer) Attaches(el *domain.EditorJSDataAttaches) string {
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+
+	return `\href{` + urlEscaper.Replace(el.File.URL) + `}{` + Escape(name) + `} (` + Escape(support.HumanFileSize(el.File.Size)) + `)`
+}
+
+func (r Renderer) Embed(el *domain.EditorJSDataEmbed) string {
+	link := `\href{` + urlEscaper.Replace(el.Source) + `}{Watch on ` + Escape(el.Service) + `}`
+
+	if el.Caption != "" {
+		return Inline(el.Caption) + "\\par\n" + link
+	}
+
+	return link
+}
+
+func (r Renderer) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	var result []string
+
+	result = append(result, `\begin{figure}[h]`, `\centering`)
+
+	for _, url := range el.URLs {
+		if remote(url) {
+			result = append(result, `\url{`+urlEscaper.Replace(url)+`}\par`)
+			continue
+		}
+		result = append(result, `\includegraphics[width=0.3\linewidth]{`+graphicsPath(url)+`}`)
+	}
+
+	result = append(result, `\end{figure}`)
+
+	return strings.Join(result, "\n")
+}
+
+func align(content, alignment string) string {
+	switch alignment {
+	case "center":
+		return "\\begin{center}\n" + content + "\n\\end{center}"
+	case "right":
+		return "\\begin{flushright}\n" + content + "\n\\end{flushright}"
+	}
+
+	return content
+}
+
+// verbatim can't contain its own end marker, so that sequence is broken up.
+func verbatim(code string) string {
+	return "\\begin{verbatim}\n" + strings.ReplaceAll(code, `\end{verbatim}`, `\end {verbatim}`) + "\n\\end{verbatim}"
+}
+
+func label(anchor string) string {
+	return strings.NewReplacer(" ", "-", "{", "", "}", "", `\`, "", "#", "", "%", "").Replace(strings.ToLower(anchor))
+}
+
+func remote(url string) bool {
+	return strings.Contains(url, "://") || strings.HasPrefix(url, "//")
+}
+
+// graphicsPath protects the characters \includegraphics can't take in a file
+// name.
+func graphicsPath(url string) string {
+	return strings.NewReplacer(`\`, `/`, `{`, ``, `}`, ``, `%`, `\%`, `#`, `\#`).Replace(url)
+}
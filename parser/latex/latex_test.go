@@ -0,0 +1,94 @@
+package latex
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func parse(t *testing.T, blocks string) string {
+	out, err := Renderer{}.Parse(`{"blocks": [`+blocks+`]}`, support.Limits{})
+	assert.NoError(t, err)
+	return out
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `50\% \& \$5 \#1 a\_b \{x\} \textbackslash{} \textasciitilde{} \textasciicircum{}`, Escape(`50% & $5 #1 a_b {x} \ ~ ^`))
+}
+
+func TestInline(t *testing.T) {
+	assert.Equal(t, `\textbf{bold} \emph{it} \texttt{a\_b} \href{https://example.com/a\%20b}{link}`,
+		Inline(`<b>bold</b> <i>it</i> <code class="inline-code">a_b</code> <a href="https://example.com/a%20b">link</a>`))
+}
+
+func TestHeader(t *testing.T) {
+	assert.Equal(t, `\section{Title}`, parse(t, `{"type": "header", "data": {"level": 1, "text": "Title"}}`))
+	assert.Equal(t, `\subsubsection{A \& B}\label{intro}`, parse(t, `{"type": "header", "data": {"level": 3, "text": "A &amp; B", "anchor": "Intro"}}`))
+	assert.Equal(t, `\section{\textbf{Marked} text here}`, parse(t, `{"type": "header", "data": {"level": 1, "text": "<b><mark>Marked</mark></b> <u>text</u><br><s>here</s>"}}`)) // ulem and soul can't be used in moving arguments
+}
+
+func TestNestedList(t *testing.T) {
+	expected := `\begin{itemize}
+  \item one
+  \begin{itemize}
+    \item two
+  \end{itemize}
+\end{itemize}`
+
+	assert.Equal(t, expected, parse(t, `{"type": "list", "data": {"style": "unordered", "items": [{"content": "one", "items": [{"content": "two", "items": []}]}]}}`))
+	assert.Contains(t, parse(t, `{"type": "list", "data": {"style": "ordered", "items": ["a", "b"]}}`), `\begin{enumerate}`)
+}
+
+func TestTable(t *testing.T) {
+	expected := `\begin{center}
+\begin{tabular}{|l|l|}
+\hline
+\textbf{Name} & \textbf{Value} \\
+\hline
+a &  \\
+\hline
+\end{tabular}
+\end{center}`
+
+	assert.Equal(t, expected, parse(t, `{"type": "table", "data": {"withHeadings": true, "content": [["Name", "Value"], ["a"]]}}`))
+	assert.Contains(t, parse(t, `{"type": "table", "data": {"content": [["first<br>second", "<u>u</u>"]]}}`), "first second & \\uline{u} \\\\") // l columns can't break lines
+}
+
+func TestCode(t *testing.T) {
+	assert.Equal(t, "\\begin{lstlisting}[language=Python]\nprint(1)\n\\end{lstlisting}", parse(t, `{"type": "code", "data": {"code": "print(1)", "languageCode": "python"}}`))
+	assert.Equal(t, "\\begin{lstlisting}\nlet a\n\\end{lstlisting}", parse(t, `{"type": "code", "data": {"code": "let a", "languageCode": "rust"}}`))
+	assert.Equal(t, "\\begin{verbatim}\n$x_1$\n\\end{verbatim}", parse(t, `{"type": "code", "data": {"code": "$x_1$"}}`))
+	assert.Equal(t, "\\begin{lstlisting}[language={[Sharp]C}]\n// \\end {lstlisting}\n\\end{lstlisting}", parse(t, `{"type": "code", "data": {"code": "// \\end{lstlisting}", "languageCode": "csharp"}}`))
+}
+
+func TestImage(t *testing.T) {
+	expected := `\begin{figure}[h]
+\centering
+\includegraphics[width=\linewidth]{images/a cat.png}
+\caption{A cat}
+\end{figure}`
+
+	assert.Equal(t, expected, parse(t, `{"type": "image", "data": {"file": {"url": "images/a cat.png"}, "caption": "A cat"}}`))
+	assert.Contains(t, parse(t, `{"type": "image", "data": {"file": {"url": "a.png"}, "caption": "A <mark>marked</mark> cat"}}`), `\caption{A marked cat}`)
+}
+
+func TestRemoteImages(t *testing.T) {
+	assert.Equal(t, "\\begin{center}\n\\href{https://example.com/cat.png}{A \\textbf{cat}}\n\\end{center}", parse(t, `{"type": "image", "data": {"file": {"url": "https://example.com/cat.png"}, "caption": "A <b>cat</b>"}}`))
+	assert.Equal(t, "\\begin{center}\n\\url{https://example.com/100\\%.png}\n\\end{center}", parse(t, `{"type": "image", "data": {"url": "https://example.com/100%.png"}}`))
+
+	expected := `\begin{figure}[h]
+\centering
+\url{https://example.com/1.jpg}\par
+\includegraphics[width=0.3\linewidth]{images/2.jpg}
+\end{figure}`
+
+	assert.Equal(t, expected, parse(t, `{"type": "imageGallery", "data": {"urls": ["https://example.com/1.jpg", "images/2.jpg"]}}`))
+}
+
+func TestStandalone(t *testing.T) {
+	out, err := Renderer{Standalone: true}.Parse(`{"blocks": [{"type": "paragraph", "data": {"text": "Hi"}}]}`, support.Limits{})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(out, `\documentclass`))
+	assert.Contains(t, out, "\\begin{document}\n\nHi\n\n\\end{document}")
+}
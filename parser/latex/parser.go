@@ -0,0 +1,50 @@
+package latex
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"log"
+	"strings"
+)
+
+// Parser renders the document within support.DefaultLimits. Errors are
+// logged and give an empty string; ParserWithLimits returns them.
+func Parser(jsonstr string) string {
+	latexStr, err := ParserWithLimits(jsonstr, support.DefaultLimits)
+	if err != nil {
+		log.Println("Error parsing the input json\n", err)
+	}
+
+	return latexStr
+}
+
+func ParserWithLimits(jsonstr string, limits support.Limits) (string, error) {
+	return Renderer{}.Parse(jsonstr, limits)
+}
+
+func (r Renderer) Parse(jsonstr string, limits support.Limits) (string, error) {
+	result, err := support.RenderBlocks(jsonstr, limits, r)
+	if err != nil {
+		return "", err
+	}
+
+	body := strings.Join(result[:], "\n\n")
+
+	if r.Standalone {
+		return preamble + "\n\\begin{document}\n\n" + body + "\n\n\\end{document}\n", nil
+	}
+
+	return body, nil
+}
+
+const preamble = `\documentclass{article}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{amssymb}
+\usepackage{graphicx}
+\usepackage{listings}
+\usepackage[normalem]{ulem}
+\usepackage{xcolor}
+\usepackage{soul}
+\usepackage{hyperref}
+
+\lstset{basicstyle=\ttfamily\small,breaklines=true,frame=single}`
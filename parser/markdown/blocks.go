@@ -0,0 +1,75 @@
+package markdown
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/domain"
+)
+
+var defaultRenderer = Renderer{}
+
+func Header(el *domain.EditorJSDataHeader) string {
+	return defaultRenderer.Header(el)
+}
+
+func Paragraph(el *domain.EditorJSDataParagraph) string {
+	return defaultRenderer.Paragraph(el)
+}
+
+func Quote(el *domain.EditorJSDataQuote) string {
+	return defaultRenderer.Quote(el)
+}
+
+func Warning(el *domain.EditorJSDataWarning) string {
+	return defaultRenderer.Warning(el)
+}
+
+func Delimiter() string {
+	return defaultRenderer.Delimiter()
+}
+
+func Alert(el *domain.EditorJSDataAlert) string {
+	return defaultRenderer.Alert(el)
+}
+
+func List(el *domain.EditorJSDataList) (string, error) {
+	return defaultRenderer.List(el)
+}
+
+func Checklist(el *domain.EditorJSDataChecklist) string {
+	return defaultRenderer.Checklist(el)
+}
+
+func Table(el *domain.EditorJSDataTable) string {
+	return defaultRenderer.Table(el)
+}
+
+func AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return defaultRenderer.AnyButton(el)
+}
+
+func Code(el *domain.EditorJSDataCode) string {
+	return defaultRenderer.Code(el)
+}
+
+func Raw(el *domain.EditorJSDataRaw) string {
+	return defaultRenderer.Raw(el)
+}
+
+func Image(el *domain.EditorJSDataImage) string {
+	return defaultRenderer.Image(el)
+}
+
+func LinkTool(el *domain.EditorJSDataLinkTool) string {
+	return defaultRenderer.LinkTool(el)
+}
+
+func Attaches(el *domain.EditorJSDataAttaches) string {
+	return defaultRenderer.Attaches(el)
+}
+
+func Embed(el *domain.EditorJSDataEmbed) string {
+	return defaultRenderer.Embed(el)
+}
+
+func ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	return defaultRenderer.ImageGallery(el)
+}
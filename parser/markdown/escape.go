@@ -0,0 +1,91 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`~`, `\~`,
+	"\u00a0", " ",
+)
+
+var urlEscaper = strings.NewReplacer(
+	` `, `%20`,
+	`(`, `%28`,
+	`)`, `%29`,
+	`<`, `%3C`,
+	`>`, `%3E`,
+)
+
+const hardBreak = "\\\n"
+
+var (
+	entityPattern      = regexp.MustCompile(`&(#?[A-Za-z0-9]+;)`)
+	orderedPattern     = regexp.MustCompile(`^(\d{1,9})([.)])(\s|$)`)
+	setextPattern      = regexp.MustCompile(`^(=+|-+)\s*$`)
+	closingHashPattern = regexp.MustCompile(`(^|\s)(#+)\s*$`)
+)
+
+// Escape escapes Markdown metacharacters in plain text.
+func Escape(s string) string {
+	return entityPattern.ReplaceAllString(textEscaper.Replace(s), `\&$1`)
+}
+
+// escapeLineStarts escapes the characters that would turn a line of
+// paragraph text into another block: headings, quotes, list items, setext
+// underlines and thematic breaks.
+func escapeLineStarts(text string) string {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " \t")
+
+		switch {
+		case line == "":
+		case setextPattern.MatchString(line):
+			line = `\` + line
+		case orderedPattern.MatchString(line):
+			line = orderedPattern.ReplaceAllString(line, `$1\$2$3`)
+		case strings.HasPrefix(line, "#"), strings.HasPrefix(line, ">"):
+			line = `\` + line
+		case (line[0] == '-' || line[0] == '+') && (len(line) == 1 || line[1] == ' ' || line[1] == '\t'):
+			line = `\` + line
+		}
+
+		lines[i] = line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// escapeHeading keeps heading text on a single line and protects trailing
+// hashes that would otherwise be read as a closing sequence.
+func escapeHeading(text string) string {
+	text = strings.ReplaceAll(text, hardBreak, " ")
+	text = strings.ReplaceAll(text, "\n", " ")
+
+	return closingHashPattern.ReplaceAllString(text, `$1\$2`)
+}
+
+// escapeTableCell keeps cell text on a single line and escapes the pipes
+// that would split it, including those inside code spans.
+func escapeTableCell(text string) string {
+	text = strings.ReplaceAll(text, hardBreak, "<br>")
+	text = strings.ReplaceAll(text, "\n", " ")
+
+	return strings.ReplaceAll(text, "|", `\|`)
+}
+
+// indentContinuation indents every line after the first so that it stays
+// inside a list item whose content starts at the given column.
+func indentContinuation(text string, column int) string {
+	return strings.ReplaceAll(text, "\n", "\n"+strings.Repeat(" ", column))
+}
@@ -0,0 +1,153 @@
+package markdown
+
+import (
+	"strings"
+)
+
+type Flavor string
+
+const (
+	Default    Flavor = ""
+	CommonMark Flavor = "commonmark"
+	GFM        Flavor = "gfm"
+	Hugo       Flavor = "hugo"
+	MkDocs     Flavor = "mkdocs"
+	Docusaurus Flavor = "docusaurus"
+)
+
+const (
+	admonitionLegacy     = "legacy"
+	admonitionBlockquote = "blockquote"
+	admonitionGFM        = "gfm"
+	admonitionMkDocs     = "mkdocs"
+	admonitionDocusaurus = "docusaurus"
+)
+
+type profile struct {
+	admonition    string
+	anchors       bool
+	shortcodes    bool
+	htmlAlignment bool
+	tables        bool
+	htmlMark      bool
+	htmlStrike    bool
+}
+
+var profiles = map[Flavor]profile{
+	Default: {
+		admonition: admonitionLegacy,
+		tables:     true,
+	},
+	CommonMark: {
+		admonition:    admonitionBlockquote,
+		htmlAlignment: true,
+		htmlMark:      true,
+		htmlStrike:    true,
+	},
+	GFM: {
+		admonition:    admonitionGFM,
+		htmlAlignment: true,
+		tables:        true,
+		htmlMark:      true,
+	},
+	Hugo: {
+		admonition:    admonitionGFM,
+		anchors:       true,
+		shortcodes:    true,
+		htmlAlignment: true,
+		tables:        true,
+		htmlMark:      true,
+	},
+	MkDocs: {
+		admonition:    admonitionMkDocs,
+		anchors:       true,
+		htmlAlignment: true,
+		tables:        true,
+	},
+	Docusaurus: {
+		admonition:    admonitionDocusaurus,
+		anchors:       true,
+		htmlAlignment: true,
+		tables:        true,
+		htmlMark:      true,
+	},
+}
+
+func Flavors() []Flavor {
+	return []Flavor{CommonMark, GFM, Hugo, MkDocs, Docusaurus}
+}
+
+func (f Flavor) profile() profile {
+	if p, ok := profiles[f]; ok {
+		return p
+	}
+	return profiles[Default]
+}
+
+// admonitionKind maps the alert types of the Editor.js alert plugin to the
+// admonition kinds understood by the given style.
+func admonitionKind(style, alertType string) string {
+	kind := "note"
+
+	switch alertType {
+	case "success":
+		kind = "tip"
+	case "warning":
+		kind = "warning"
+	case "danger":
+		kind = "danger"
+	case "info":
+		kind = "info"
+	}
+
+	if style == admonitionGFM {
+		switch kind {
+		case "danger":
+			return "CAUTION"
+		case "info":
+			return "NOTE"
+		}
+		return strings.ToUpper(kind)
+	}
+
+	return kind
+}
+
+func anchorID(anchor string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(anchor), " ", "-"))
+}
+
+func prefixLines(text, prefix string) string {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = strings.TrimRight(prefix, " ")
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// embedShortcode returns the Hugo shortcode for the services Hugo ships
+// built-in shortcodes for.
+func embedShortcode(service, embed string) string {
+	id := embed
+	if i := strings.LastIndex(strings.TrimRight(embed, "/"), "/"); i >= 0 {
+		id = strings.TrimRight(embed, "/")[i+1:]
+	}
+	if i := strings.IndexAny(id, "?#"); i >= 0 {
+		id = id[:i]
+	}
+
+	switch strings.ToLower(service) {
+	case "youtube":
+		return "{{< youtube " + id + " >}}"
+	case "vimeo":
+		return "{{< vimeo " + id + " >}}"
+	}
+
+	return ""
+}
@@ -0,0 +1,108 @@
+package markdown
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/BurntSushi/toml"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"gopkg.in/yaml.v3"
+	"strings"
+	"time"
+)
+
+type FrontMatterFormat string
+
+const (
+	NoFrontMatter FrontMatterFormat = ""
+	YAML          FrontMatterFormat = "yaml"
+	TOML          FrontMatterFormat = "toml"
+	JSON          FrontMatterFormat = "json"
+)
+
+// FrontMatterFields collects the fields derived from the document: the title
+// comes from the first header, the description from the first paragraph, the
+// date from the Editor.js time and the image from the first image block.
+func FrontMatterFields(editorJS domain.EditorJS) map[string]interface{} {
+	fields := map[string]interface{}{}
+
+	if editorJS.Time > 0 {
+		fields["date"] = time.UnixMilli(editorJS.Time).UTC()
+	}
+
+	for _, el := range editorJS.Blocks {
+		switch el.Type {
+		case "header":
+			if _, ok := fields["title"]; !ok {
+				fields["title"] = plainText(support.PrepareData(el).(*domain.EditorJSDataHeader).Text)
+			}
+		case "paragraph":
+			if _, ok := fields["description"]; !ok {
+				fields["description"] = plainText(support.PrepareData(el).(*domain.EditorJSDataParagraph).Text)
+			}
+		case "image":
+			if _, ok := fields["image"]; !ok {
+				image := support.PrepareData(el).(*domain.EditorJSDataImage)
+				if image.File.URL != "" {
+					fields["image"] = image.File.URL
+				} else {
+					fields["image"] = image.URL
+				}
+			}
+		}
+	}
+
+	return fields
+}
+
+func FrontMatter(format FrontMatterFormat, fields map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+
+	switch format {
+	case YAML:
+		buf.WriteString("---\n")
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(fields); err != nil {
+			return "", err
+		}
+		if err := enc.Close(); err != nil {
+			return "", err
+		}
+		buf.WriteString("---")
+
+	case TOML:
+		buf.WriteString("+++\n")
+		if err := toml.NewEncoder(&buf).Encode(fields); err != nil {
+			return "", err
+		}
+		buf.WriteString("+++")
+
+	case JSON:
+		content, err := json.MarshalIndent(fields, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		buf.Write(content)
+	}
+
+	return buf.String(), nil
+}
+
+func (r Renderer) frontMatter(editorJS domain.EditorJS) (string, error) {
+	if r.FrontMatter == NoFrontMatter {
+		return "", nil
+	}
+
+	fields := FrontMatterFields(editorJS)
+	for key, value := range r.FrontMatterFields {
+		fields[key] = value
+	}
+
+	return FrontMatter(r.FrontMatter, fields)
+}
+
+func plainText(s string) string {
+	return strings.TrimSpace(strings.ReplaceAll(inline.PlainText(inline.Parse(s)), "\u00a0", " "))
+}
@@ -0,0 +1,104 @@
+package markdown
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"strings"
+)
+
+type inlineRenderer struct {
+	profile profile
+}
+
+// Inline converts the inline HTML of Editor.js text into Markdown, escaping
+// Markdown metacharacters found in plain text.
+func Inline(s string) string {
+	return defaultRenderer.Inline(s)
+}
+
+func (r Renderer) Inline(s string) string {
+	return inline.Convert(s, inlineRenderer{profile: r.Flavor.profile()})
+}
+
+func (inlineRenderer) Text(text string) string {
+	return Escape(text)
+}
+
+func (inlineRenderer) Bold(inner string) string {
+	return inline.Wrap(inner, "**", "**")
+}
+
+func (inlineRenderer) Italic(inner string) string {
+	return inline.Wrap(inner, "_", "_")
+}
+
+func (inlineRenderer) Underline(inner string) string {
+	return inner
+}
+
+func (ir inlineRenderer) Strike(inner string) string {
+	if ir.profile.htmlStrike {
+		return "<del>" + inner + "</del>"
+	}
+	return inline.Wrap(inner, "~~", "~~")
+}
+
+func (inlineRenderer) Code(text string) string {
+	return codeSpan(text)
+}
+
+func (ir inlineRenderer) Mark(inner string) string {
+	if ir.profile.htmlMark {
+		return "<mark>" + inner + "</mark>"
+	}
+	return inline.Wrap(inner, "==", "==")
+}
+
+func (inlineRenderer) Link(inner, href string) string {
+	if href == "" {
+		return inner
+	}
+	return "[" + inner + "](" + urlEscaper.Replace(href) + ")"
+}
+
+func (inlineRenderer) Break() string {
+	return hardBreak
+}
+
+func codeSpan(text string) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+
+	fence := strings.Repeat("`", longestBacktickRun(text)+1)
+
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+
+	return fence + text + fence
+}
+
+// codeFence returns a fence longer than any backtick run in code, so the
+// code cannot close its own block.
+func codeFence(code string) string {
+	longest := longestBacktickRun(code) + 1
+	if longest < 3 {
+		longest = 3
+	}
+
+	return strings.Repeat("`", longest)
+}
+
+func longestBacktickRun(text string) int {
+	longest, run := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			run++
+			if run > longest {
+				longest = run
+			}
+		} else {
+			run = 0
+		}
+	}
+
+	return longest
+}
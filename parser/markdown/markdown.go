@@ -1,187 +1,205 @@
 package markdown
 
 import (
-	"encoding/json"
 	"fmt"
-	"log"
-	"rbsite/internal/editorjs/support"
-	"rbsite/internal/editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
 	"strconv"
 	"strings"
 )
 
-func Header(el *domain.EditorJSDataHeader) string {
+type Renderer struct {
+	Flavor            Flavor
+	FrontMatter       FrontMatterFormat
+	FrontMatterFields map[string]interface{}
+}
+
+func New(flavor Flavor) Renderer {
+	return Renderer{Flavor: flavor}
+}
+
+func (r Renderer) Header(el *domain.EditorJSDataHeader) string {
 	headerLevel := ""
 
 	for i := 0; i < el.Level; i++ {
 		headerLevel += "#"
 	}
 
-	return fmt.Sprintf("%s %s", headerLevel, el.Text)
+	header := fmt.Sprintf("%s %s", headerLevel, escapeHeading(r.Inline(el.Text)))
+
+	if r.Flavor.profile().anchors && el.Anchor != "" {
+		header += ` {#` + anchorID(el.Anchor) + `}`
+	}
+
+	return header
 }
 
-func Paragraph(el *domain.EditorJSDataParagraph) string {
-	return fmt.Sprintf("%s", el.Text)
+func (r Renderer) Paragraph(el *domain.EditorJSDataParagraph) string {
+	return r.align(fmt.Sprintf("%s", r.block(el.Text)), el.Alignment)
 }
 
-func Quote(el *domain.EditorJSDataQuote) string {
-	quoteMD := `> ` + el.Text + `
+func (r Renderer) Quote(el *domain.EditorJSDataQuote) string {
+	if r.Flavor.profile().admonition == admonitionLegacy {
+		quoteMD := prefixLines(r.block(el.Text), "> ") + `
 >
-> --- ` + el.Caption
+> --- ` + r.Inline(el.Caption)
+
+		return fmt.Sprintf("%s", quoteMD)
+	}
+
+	quoteMD := prefixLines(r.block(el.Text), "> ")
+	if el.Caption != "" {
+		quoteMD += "\n>\n> — " + r.Inline(el.Caption)
+	}
 
-	return fmt.Sprintf("%s", quoteMD)
+	return r.align(quoteMD, el.Alignment)
 }
 
-func Warning(el *domain.EditorJSDataWarning) string {
-	warningMD := `> ` + el.Title + `
+func (r Renderer) Warning(el *domain.EditorJSDataWarning) string {
+	title := escapeHeading(r.Inline(el.Title))
+	message := r.block(el.Message)
+
+	switch r.Flavor.profile().admonition {
+	case admonitionBlockquote:
+		return prefixLines(inline.Wrap(title, "**", "**")+"\n\n"+message, "> ")
+	case admonitionGFM:
+		return prefixLines("[!WARNING]\n"+inline.Wrap(title, "**", "**")+"\n\n"+message, "> ")
+	case admonitionMkDocs:
+		return `!!! warning "` + strings.ReplaceAll(inline.PlainText(inline.Parse(el.Title)), `"`, `\"`) + `"` + "\n\n" + prefixLines(message, "    ")
+	case admonitionDocusaurus:
+		return ":::warning[" + title + "]\n\n" + message + "\n\n:::"
+	}
+
+	warningMD := `> ` + title + `
 >
-> --- ` + el.Message
+> --- ` + strings.ReplaceAll(message, "\n", "\n> ")
 
 	return fmt.Sprintf("%s", warningMD)
 }
 
-func Delimiter() string {
+func (r Renderer) Delimiter() string {
 	delimiterMD := `
 ***
 `
 	return fmt.Sprintf("%s", delimiterMD)
 }
 
-func Alert(el *domain.EditorJSDataAlert) string {
+func (r Renderer) Alert(el *domain.EditorJSDataAlert) string {
+	style := r.Flavor.profile().admonition
+	message := r.block(el.Message)
+	kind := admonitionKind(style, el.Type)
+
+	switch style {
+	case admonitionBlockquote:
+		return prefixLines(message, "> ")
+	case admonitionGFM:
+		return prefixLines("[!"+kind+"]\n"+message, "> ")
+	case admonitionMkDocs:
+		return "!!! " + kind + "\n\n" + prefixLines(message, "    ")
+	case admonitionDocusaurus:
+		return ":::" + kind + "\n\n" + message + "\n\n:::"
+	}
+
 	alertMD := `
-| ` + el.Message + ` |
+| ` + escapeTableCell(message) + ` |
 | --- |`
 
 	return fmt.Sprintf("%s", alertMD)
 }
 
-func List(el *domain.EditorJSDataList) string {
-	var result []string
-
-	items, err := json.Marshal(el.Items)
+func (r Renderer) List(el *domain.EditorJSDataList) (string, error) {
+	itemsList, err := support.ListItems(el.Items)
 	if err != nil {
-		log.Fatal(err)
+		return "", err
 	}
 
-	var itemsList []domain.NestedListItem
-
-	err = json.Unmarshal(items, &itemsList)
-	if err == nil {
-		result = append(result, support.CreateMarkDownNestedList(itemsList, el.Style, ""))
+	return support.CreateMarkDownNestedList(r.inlineItems(itemsList), el.Style, ""), nil
+}
 
-	} else {
-		if el.Style == "unordered" {
-			for _, item := range el.Items {
-				result = append(result, "- "+fmt.Sprintf("%v", item))
-			}
+func (r Renderer) Checklist(el *domain.EditorJSDataChecklist) string {
+	var result []string
 
+	for _, item := range el.Items {
+		if item.Checked {
+			result = append(result, `- [x] `+indentContinuation(r.block(item.Text), 6))
 		} else {
-			for i, item := range el.Items {
-				n := strconv.Itoa(i+1) + "."
-				result = append(result, fmt.Sprintf("%s %s", n, item))
-			}
+			result = append(result, `- [ ] `+indentContinuation(r.block(item.Text), 6))
 		}
 	}
 
 	return strings.Join(result[:], "\n")
 }
 
-func Checklist(el *domain.EditorJSDataChecklist) string {
-	var result []string
-
-	items, err := json.Marshal(el.Items)
-	if err != nil {
-		log.Fatal(err)
+func (r Renderer) Table(el *domain.EditorJSDataTable) string {
+	// Pipe tables need a header row, so tables without one are kept as HTML.
+	if !r.Flavor.profile().tables || !el.WithHeadings {
+		return r.htmlTable(el)
 	}
 
-	var itemsList []domain.ChecklistItem
-
-	err = json.Unmarshal(items, &itemsList)
-	if err == nil {
-
-		for _, item := range itemsList {
-			if item.Checked {
-				result = append(result, `- [x] `+item.Text)
-			} else {
-				result = append(result, `- [ ] `+item.Text)
-			}
+	columns := 0
+	for _, line := range el.Content {
+		if len(line) > columns {
+			columns = len(line)
 		}
 	}
 
-	return strings.Join(result[:], "\n")
-}
+	if columns == 0 {
+		return ""
+	}
 
-func Table(el *domain.EditorJSDataTable) string {
 	var result []string
 
 	for index, line := range el.Content {
+		lineData := `|`
 
-		if el.WithHeadings && index == 0 {
-			lineTitle := `|`
-			lineSeparator := `|`
-
-			for _, info := range line {
-				lineTitle += ` ` + info + ` |`
-				lineSeparator += `---|`
-			}
-
-			result = append(result, lineTitle)
-			result = append(result, lineSeparator)
-
-		} else if index == 0 {
-			lineTitle := `|`
-			lineSeparator := `|`
-			lineContent := `|`
-
-			for _, info := range line {
-				lineTitle += ` |`
-				lineSeparator += `---|`
-				lineContent += ` ` + info + ` |`
+		for column := 0; column < columns; column++ {
+			info := ""
+			if column < len(line) {
+				info = escapeTableCell(r.Inline(line[column]))
 			}
+			lineData += ` ` + info + ` |`
+		}
 
-			result = append(result, lineTitle)
-			result = append(result, lineSeparator)
-			result = append(result, lineContent)
-
-		} else {
-			lineData := `|`
-
-			for _, info := range line {
-				lineData += ` ` + info + ` |`
-			}
+		result = append(result, lineData)
 
-			result = append(result, lineData)
+		if index == 0 {
+			result = append(result, `|`+strings.Repeat(`---|`, columns))
 		}
 	}
 
 	return strings.Join(result, "\n")
 }
 
-func AnyButton(el *domain.EditorJSDataAnyButton) string {
-	return fmt.Sprintf(`[%s](%s)`, el.Text, el.Link)
+func (r Renderer) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return fmt.Sprintf(`[%s](%s)`, r.Inline(el.Text), urlEscaper.Replace(el.Link))
 }
 
-func Code(el *domain.EditorJSDataCode) string {
+func (r Renderer) Code(el *domain.EditorJSDataCode) string {
 	var result []string
 
-	result = append(result, "```"+el.LanguageCode)
+	fence := codeFence(el.Code)
+
+	result = append(result, fence+el.LanguageCode)
 	result = append(result, el.Code)
-	result = append(result, "```")
+	result = append(result, fence)
 
 	return strings.Join(result, "\n")
 }
 
-func Raw(el *domain.EditorJSDataRaw) string {
+func (r Renderer) Raw(el *domain.EditorJSDataRaw) string {
 	var result []string
 
-	result = append(result, "```")
+	fence := codeFence(el.Html)
+
+	result = append(result, fence)
 	result = append(result, el.Html)
-	result = append(result, "```")
+	result = append(result, fence)
 
 	return strings.Join(result, "\n")
 }
 
-func Image(el *domain.EditorJSDataImage) string {
+func (r Renderer) Image(el *domain.EditorJSDataImage) string {
 	url := ""
 
 	if el.File.URL != "" {
@@ -190,67 +208,139 @@ func Image(el *domain.EditorJSDataImage) string {
 		url = el.URL
 	}
 
-	return fmt.Sprintf(`![%s](%s)`, el.Caption, url)
+	return fmt.Sprintf(`![%s](%s)`, Escape(inline.PlainText(inline.Parse(el.Caption))), urlEscaper.Replace(url))
 }
 
-func LinkTool(el *domain.EditorJSDataLinkTool) string {
+func (r Renderer) LinkTool(el *domain.EditorJSDataLinkTool) string {
 	var result []string
 
 	result = append(result, "---")
 	result = append(result, "")
-	result = append(result, `# `+el.Meta.Title)
+	result = append(result, `# `+escapeHeading(r.Inline(el.Meta.Title)))
 	result = append(result, "")
-	result = append(result, `![`+el.Meta.Title+`](`+el.Meta.Image.URL+`)`)
+	result = append(result, `![`+Escape(el.Meta.Title)+`](`+urlEscaper.Replace(el.Meta.Image.URL)+`)`)
 	result = append(result, "")
-	result = append(result, `*`+el.Meta.Description+`*`)
+	result = append(result, inline.Wrap(r.Inline(el.Meta.Description), `*`, `*`))
 	result = append(result, "")
-	result = append(result, `[`+strings.ReplaceAll(strings.ReplaceAll(el.Link, "https://", ""), "http://", "")+`](`+el.Link+`)`)
+	result = append(result, `[`+Escape(strings.ReplaceAll(strings.ReplaceAll(el.Link, "https://", ""), "http://", ""))+`](`+urlEscaper.Replace(el.Link)+`)`)
 	result = append(result, "")
 	result = append(result, "---")
 
 	return strings.Join(result, "\n")
 }
 
-func Attaches(el *domain.EditorJSDataAttaches) string {
+func (r Renderer) Attaches(el *domain.EditorJSDataAttaches) string {
 	var result []string
 
 	result = append(result, "---")
 	result = append(result, "")
-	result = append(result, `### `+el.File.Name)
+	result = append(result, `### `+escapeHeading(Escape(el.File.Name)))
 	result = append(result, "")
 	result = append(result, `###### `+support.HumanFileSize(el.File.Size))
 	result = append(result, "")
-	result = append(result, `[Download](`+el.File.URL+`)`)
+	result = append(result, `[Download](`+urlEscaper.Replace(el.File.URL)+`)`)
 	result = append(result, "")
 	result = append(result, "---")
 
 	return strings.Join(result, "\n")
 }
 
-func Embed(el *domain.EditorJSDataEmbed) string {
+func (r Renderer) Embed(el *domain.EditorJSDataEmbed) string {
 	var result []string
 
 	result = append(result, "---")
 	result = append(result, "")
-	result = append(result, `### `+el.Caption)
+	result = append(result, `### `+escapeHeading(r.Inline(el.Caption)))
 	result = append(result, "")
-	result = append(result, `[Watch on `+el.Service+`](`+el.Source+`)`)
+
+	shortcode := ""
+	if r.Flavor.profile().shortcodes {
+		shortcode = embedShortcode(el.Service, el.Embed)
+	}
+
+	if shortcode != "" {
+		result = append(result, shortcode)
+	} else {
+		result = append(result, `[Watch on `+Escape(el.Service)+`](`+urlEscaper.Replace(el.Source)+`)`)
+	}
+
 	result = append(result, "")
 	result = append(result, "---")
 
 	return strings.Join(result, "\n")
 }
 
-func ImageGallery(el *domain.EditorJSDataImageGallery) string {
+func (r Renderer) ImageGallery(el *domain.EditorJSDataImageGallery) string {
 	var result []string
 
 	result = append(result, "---")
 
 	for index, img := range el.URLs {
-		result = append(result, `![Image `+strconv.Itoa(index)+`](`+img+`)`)
+		result = append(result, `![Image `+strconv.Itoa(index)+`](`+urlEscaper.Replace(img)+`)`)
 	}
 
 	result = append(result, "---")
 
 	return strings.Join(result, "\n")
 }
+
+func (r Renderer) block(s string) string {
+	return escapeLineStarts(r.Inline(s))
+}
+
+func (r Renderer) inlineItems(items []domain.NestedListItem) []domain.NestedListItem {
+	converted := make([]domain.NestedListItem, len(items))
+
+	for i, item := range items {
+		converted[i] = domain.NestedListItem{Content: r.block(item.Content), Items: r.inlineItems(item.Items)}
+	}
+
+	return converted
+}
+
+// align falls back to an HTML wrapper for centered and right aligned blocks,
+// leaving blank lines around the content so it is still parsed as Markdown.
+func (r Renderer) align(content, alignment string) string {
+	if !r.Flavor.profile().htmlAlignment || (alignment != "center" && alignment != "right") {
+		return content
+	}
+
+	return `<div align="` + alignment + `">` + "\n\n" + content + "\n\n" + `</div>`
+}
+
+// htmlTable is used for tables without a header row and by flavors without
+// pipe tables. Blank lines around the cell content let it be read as
+// Markdown, so it is converted and escaped like any paragraph instead of
+// being passed through as HTML.
+func (r Renderer) htmlTable(el *domain.EditorJSDataTable) string {
+	if len(el.Content) == 0 {
+		return ""
+	}
+
+	var result []string
+
+	result = append(result, `<table>`)
+
+	for index, line := range el.Content {
+		tag := `td`
+		if el.WithHeadings && index == 0 {
+			tag = `th`
+		}
+
+		result = append(result, `<tr>`)
+
+		for _, info := range line {
+			if content := r.block(info); content != "" {
+				result = append(result, `<`+tag+`>`+"\n\n"+content+"\n\n"+`</`+tag+`>`)
+			} else {
+				result = append(result, `<`+tag+`></`+tag+`>`)
+			}
+		}
+
+		result = append(result, `</tr>`)
+	}
+
+	result = append(result, `</table>`)
+
+	return strings.Join(result, "\n")
+}
@@ -1,9 +1,10 @@
 package markdown
 
 import (
-	"gitlab.com/rodrigoodhin/go-editorjs-parser/support"
-	"gitlab.com/rodrigoodhin/go-editorjs-parser/support/domain"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
 	"strconv"
+	"strings"
 
 	"testing"
 
@@ -207,7 +208,8 @@ func TestListBlock(t *testing.T) {
 	expected1 := `- This is a block-styled editor
 - Clean output data
 - Simple and powerful API`
-	actual1 := List(content1.(*domain.EditorJSDataList))
+	actual1, err := List(content1.(*domain.EditorJSDataList))
+	assert.NoError(t, err)
 	assert.Equal(t, expected1, actual1)
 
 	input2 := `{
@@ -303,7 +305,8 @@ func TestListBlock(t *testing.T) {
         1. DT 180
     3. Honda
         1. VFR 750R`
-	actual2 := List(content2.(*domain.EditorJSDataList))
+	actual2, err := List(content2.(*domain.EditorJSDataList))
+	assert.NoError(t, err)
 	assert.Equal(t, expected2, actual2)
 }
 
@@ -411,11 +414,11 @@ func TestTableBlock(t *testing.T) {
 	editorJSON2 := support.ParseEditorJSON(input2)
 	content2 := support.PrepareData(editorJSON2.Blocks[0])
 
-	expected2 := `| | | |
-|---|---|---|
-| Kine | 1 pcs | 100$ |
-| Pigs | 3 pcs | 200$ |
-| Chickens | 12 pcs | 150$ |`
+	expected2 := "<table>\n" +
+		"<tr>\n<td>\n\nKine\n\n</td>\n<td>\n\n1 pcs\n\n</td>\n<td>\n\n100$\n\n</td>\n</tr>\n" +
+		"<tr>\n<td>\n\nPigs\n\n</td>\n<td>\n\n3 pcs\n\n</td>\n<td>\n\n200$\n\n</td>\n</tr>\n" +
+		"<tr>\n<td>\n\nChickens\n\n</td>\n<td>\n\n12 pcs\n\n</td>\n<td>\n\n150$\n\n</td>\n</tr>\n" +
+		"</table>"
 	actual2 := Table(content2.(*domain.EditorJSDataTable))
 	assert.Equal(t, expected2, actual2)
 }
@@ -693,3 +696,160 @@ func TestImageGalleryBlock(t *testing.T) {
 	actual := ImageGallery(content.(*domain.EditorJSDataImageGallery))
 	assert.Equal(t, expected, actual)
 }
+
+func TestInlineMarkup(t *testing.T) {
+	cases := map[string]string{
+		`I am <b>bold</b> and <i>italic</i>`:               `I am **bold** and _italic_`,
+		`<b>spaced </b>word`:                               `**spaced** word`,
+		`Visit <a href="https://codex.so">CodeX</a>`:       `Visit [CodeX](https://codex.so)`,
+		`Run <code class="inline-code">go test</code>`:     "Run `go test`",
+		"<code class=\"inline-code\">a`b</code>":           "``a`b``",
+		`Some <mark class="cdx-marker">marked</mark> text`: `Some ==marked== text`,
+		`first line<br>second line`:                        "first line\\\nsecond line",
+		`2 * 3 = 6 and a_b_c [x]`:                          `2 \* 3 = 6 and a\_b\_c \[x\]`,
+		`&lt;not a tag&gt; &amp; more`:                     `\<not a tag> & more`,
+		`<b>bold <i>and italic</i></b>`:                    `**bold _and italic_**`,
+		`<span class="unknown">kept</span>&nbsp;text`:      `kept text`,
+		`<a href="https://example.com/a (b)">link</a>`:     `[link](https://example.com/a%20%28b%29)`,
+	}
+
+	for input, expected := range cases {
+		assert.Equal(t, expected, Inline(input))
+	}
+
+	input := `{
+    "blocks": [
+        {
+			"type": "paragraph",
+            "data": {
+                "text": "I am <b>bold</b> with a <a href=\"https://codex.so\">link</a>"
+            }
+        }
+    ]
+}`
+
+	editorJSON := support.ParseEditorJSON(input)
+	content := support.PrepareData(editorJSON.Blocks[0])
+
+	expected := `I am **bold** with a [link](https://codex.so)`
+	actual := Paragraph(content.(*domain.EditorJSDataParagraph))
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlavorAdmonitions(t *testing.T) {
+	warning := &domain.EditorJSDataWarning{Title: "Note:", Message: "Avoid using this method."}
+	alert := &domain.EditorJSDataAlert{Type: "danger", Message: "Something <b>broke</b>."}
+
+	assert.Equal(t, "> **Note:**\n>\n> Avoid using this method.", New(CommonMark).Warning(warning))
+	assert.Equal(t, "> [!WARNING]\n> **Note:**\n>\n> Avoid using this method.", New(GFM).Warning(warning))
+	assert.Equal(t, "!!! warning \"Note:\"\n\n    Avoid using this method.", New(MkDocs).Warning(warning))
+	assert.Equal(t, ":::warning[Note:]\n\nAvoid using this method.\n\n:::", New(Docusaurus).Warning(warning))
+
+	assert.Equal(t, "> Something **broke**.", New(CommonMark).Alert(alert))
+	assert.Equal(t, "> [!CAUTION]\n> Something **broke**.", New(GFM).Alert(alert))
+	assert.Equal(t, "!!! danger\n\n    Something **broke**.", New(MkDocs).Alert(alert))
+	assert.Equal(t, ":::danger\n\nSomething **broke**.\n\n:::", New(Docusaurus).Alert(alert))
+}
+
+func TestFlavorHeaderAnchors(t *testing.T) {
+	header := &domain.EditorJSDataHeader{Level: 2, Text: "Getting started", Anchor: "Getting Started"}
+
+	assert.Equal(t, "## Getting started", New(GFM).Header(header))
+	assert.Equal(t, "## Getting started {#getting-started}", New(Hugo).Header(header))
+	assert.Equal(t, "## Getting started {#getting-started}", New(MkDocs).Header(header))
+	assert.Equal(t, "## Getting started {#getting-started}", New(Docusaurus).Header(header))
+}
+
+func TestFlavorEmbedAlignmentAndTables(t *testing.T) {
+	embed := &domain.EditorJSDataEmbed{
+		Service: "youtube",
+		Source:  "https://www.youtube.com/watch?v=viW44cUfxCE",
+		Embed:   "https://www.youtube.com/embed/viW44cUfxCE",
+		Caption: "Lamborghini",
+	}
+
+	assert.Equal(t, "---\n\n### Lamborghini\n\n{{< youtube viW44cUfxCE >}}\n\n---", New(Hugo).Embed(embed))
+	assert.Equal(t, "---\n\n### Lamborghini\n\n[Watch on youtube](https://www.youtube.com/watch?v=viW44cUfxCE)\n\n---", New(GFM).Embed(embed))
+
+	paragraph := &domain.EditorJSDataParagraph{Text: "Centered", Alignment: "center"}
+
+	assert.Equal(t, "Centered", Paragraph(paragraph))
+	assert.Equal(t, "<div align=\"center\">\n\nCentered\n\n</div>", New(GFM).Paragraph(paragraph))
+
+	table := &domain.EditorJSDataTable{WithHeadings: true, Content: [][]string{{"a", "b"}, {"1", "2"}}}
+
+	assert.Equal(t, "<table>\n<tr>\n<th>\n\na\n\n</th>\n<th>\n\nb\n\n</th>\n</tr>\n<tr>\n<td>\n\n1\n\n</td>\n<td></td>\n</tr>\n</table>", New(CommonMark).Table(&domain.EditorJSDataTable{WithHeadings: true, Content: [][]string{{"a", "b"}, {"1", ""}}}))
+	assert.Equal(t, "| a | b |\n|---|---|\n| 1 | 2 |", New(GFM).Table(table))
+
+	assert.Equal(t, "<mark>marked</mark>", New(GFM).Inline("<mark>marked</mark>"))
+	assert.Equal(t, "==marked==", New(MkDocs).Inline("<mark>marked</mark>"))
+}
+
+func TestFrontMatter(t *testing.T) {
+	input := `{
+    "time": 1635603431943,
+    "blocks": [
+        {
+            "type": "header",
+            "data": {
+                "level": 1,
+                "text": "Editor.js <i>Guide</i>"
+            }
+        },
+        {
+            "type": "paragraph",
+            "data": {
+                "text": "Everything you need to know."
+            }
+        },
+        {
+            "type": "image",
+            "data": {
+                "url": "https://codex.so/public/app/img/meta_img.png"
+            }
+        }
+    ],
+    "version": "2.22.2"
+}`
+
+	renderer := Renderer{FrontMatter: YAML, FrontMatterFields: map[string]interface{}{"draft": true}}
+	actual, err := renderer.Parse(input, support.Limits{})
+	assert.NoError(t, err)
+
+	expected := `---
+date: 2021-10-30T14:17:11.943Z
+description: Everything you need to know.
+draft: true
+image: https://codex.so/public/app/img/meta_img.png
+title: Editor.js Guide
+---
+
+# Editor.js _Guide_`
+	assert.True(t, strings.HasPrefix(actual, expected))
+
+	renderer.FrontMatter = TOML
+	actual, err = renderer.Parse(input, support.Limits{})
+	assert.NoError(t, err)
+
+	expected = `+++
+date = 2021-10-30T14:17:11.943Z
+description = "Everything you need to know."
+draft = true
+image = "https://codex.so/public/app/img/meta_img.png"
+title = "Editor.js Guide"
++++`
+	assert.True(t, strings.HasPrefix(actual, expected))
+
+	renderer.FrontMatter = JSON
+	renderer.FrontMatterFields = map[string]interface{}{"title": "Overridden"}
+	actual, err = renderer.Parse(input, support.Limits{})
+	assert.NoError(t, err)
+
+	expected = `{
+  "date": "2021-10-30T14:17:11.943Z",
+  "description": "Everything you need to know.",
+  "image": "https://codex.so/public/app/img/meta_img.png",
+  "title": "Overridden"
+}`
+	assert.True(t, strings.HasPrefix(actual, expected))
+}
@@ -0,0 +1,47 @@
+package markdown
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"log"
+	"strings"
+)
+
+// Parser renders the document within support.DefaultLimits. Errors are
+// logged and give an empty string; ParserWithLimits returns them.
+func Parser(jsonstr string) string {
+	markdownStr, err := ParserWithLimits(jsonstr, support.DefaultLimits)
+	if err != nil {
+		log.Println("Error parsing the input json\n", err)
+	}
+
+	return markdownStr
+}
+
+func ParserWithLimits(jsonstr string, limits support.Limits) (string, error) {
+	return defaultRenderer.Parse(jsonstr, limits)
+}
+
+func (r Renderer) Parse(jsonstr string, limits support.Limits) (string, error) {
+	var result []string
+
+	editorJSAST, err := support.ParseEditorJSONWithLimits(jsonstr, limits)
+	if err != nil {
+		return "", err
+	}
+
+	frontMatter, err := r.frontMatter(editorJSAST)
+	if err != nil {
+		return "", err
+	}
+
+	if frontMatter != "" {
+		result = append(result, frontMatter)
+	}
+
+	blocks, err := support.RenderEditorJSBlocks(editorJSAST.Blocks, limits, r)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(append(result, blocks...), "\n\n"), nil
+}
@@ -0,0 +1,304 @@
+package markdown
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"html"
+	"strings"
+	"testing"
+)
+
+const asciiPunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+func parseMarkdown(source string) (ast.Node, []byte) {
+	src := []byte(source)
+	md := goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+	return md.Parser().Parse(text.NewReader(src)), src
+}
+
+func plain(node ast.Node, src []byte) string {
+	var result strings.Builder
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *ast.Text:
+			result.WriteString(unescape(string(n.Segment.Value(src))))
+			if n.HardLineBreak() || n.SoftLineBreak() {
+				result.WriteString("\n")
+			}
+		case *ast.RawHTML:
+			for i := 0; i < n.Segments.Len(); i++ {
+				segment := n.Segments.At(i)
+				result.Write(segment.Value(src))
+			}
+		case *ast.String:
+			result.Write(n.Value)
+		default:
+			result.WriteString(plain(child, src))
+		}
+	}
+
+	return result.String()
+}
+
+// unescape resolves backslash escapes and entities the way a renderer
+// would, since the parser keeps them in the raw text segments.
+func unescape(s string) string {
+	var result strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.ContainsRune(asciiPunctuation, rune(s[i+1])) {
+			result.WriteByte(s[i+1])
+			i++
+			continue
+		}
+
+		if s[i] == '&' {
+			if end := strings.IndexByte(s[i:], ';'); end > 0 {
+				if entity := html.UnescapeString(s[i : i+end+1]); entity != s[i:i+end+1] {
+					result.WriteString(entity)
+					i += end
+					continue
+				}
+			}
+		}
+
+		result.WriteByte(s[i])
+	}
+
+	return result.String()
+}
+
+func kinds(node ast.Node) []ast.NodeKind {
+	var result []ast.NodeKind
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		result = append(result, child.Kind())
+	}
+
+	return result
+}
+
+func htmlCells(doc ast.Node, src []byte) []string {
+	var cells []string
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		if node.Kind() == ast.KindParagraph {
+			cells = append(cells, plain(node, src))
+		}
+	}
+
+	return cells
+}
+
+func TestRoundTripHeader(t *testing.T) {
+	cases := map[string]string{
+		"Use #":           "Use #",
+		"C# and F#":       "C# and F#",
+		"###":             "###",
+		"Line<br>break":   "Line break",
+		"1. Introduction": "1. Introduction",
+	}
+
+	for input, expected := range cases {
+		doc, src := parseMarkdown(Header(&domain.EditorJSDataHeader{Level: 2, Text: input}))
+
+		assert.Equal(t, []ast.NodeKind{ast.KindHeading}, kinds(doc), input)
+		assert.Equal(t, 2, doc.FirstChild().(*ast.Heading).Level, input)
+		assert.Equal(t, expected, plain(doc.FirstChild(), src), input)
+	}
+}
+
+func TestRoundTripParagraph(t *testing.T) {
+	cases := map[string]string{
+		"# not a heading":                 "# not a heading",
+		"1. not a list":                   "1. not a list",
+		"2) not a list":                   "2) not a list",
+		"- not a list":                    "- not a list",
+		"+ not a list":                    "+ not a list",
+		"> not a quote":                   "> not a quote",
+		"first line<br>===":               "first line\n===",
+		"first line<br>---":               "first line\n---",
+		"first line<br># still paragraph": "first line\n# still paragraph",
+		"&amp;copy; is not an entity":     "&copy; is not an entity",
+		"1999. was a good year":           "1999. was a good year",
+		"    not code":                    "not code",
+	}
+
+	for input, expected := range cases {
+		doc, src := parseMarkdown(Paragraph(&domain.EditorJSDataParagraph{Text: input}))
+
+		assert.Equal(t, []ast.NodeKind{ast.KindParagraph}, kinds(doc), input)
+		assert.Equal(t, expected, plain(doc.FirstChild(), src), input)
+	}
+}
+
+func TestRoundTripTable(t *testing.T) {
+	table := &domain.EditorJSDataTable{
+		WithHeadings: true,
+		Content: [][]string{
+			{"Operator", "Meaning", "Example"},
+			{"|", "pipe", `<code class="inline-code">a | b</code>`},
+			{"||", "first<br>second"},
+		},
+	}
+
+	for _, flavor := range []Flavor{Default, GFM, Hugo, MkDocs, Docusaurus} {
+		output := New(flavor).Table(table)
+		lines := strings.Split(output, "\n")
+		assert.Equal(t, "|---|---|---|", lines[1], flavor)
+
+		doc, src := parseMarkdown(output)
+		assert.Equal(t, []ast.NodeKind{east.KindTable}, kinds(doc), flavor)
+
+		var rows [][]string
+		for row := doc.FirstChild().FirstChild(); row != nil; row = row.NextSibling() {
+			var cells []string
+			for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+				cells = append(cells, plain(cell, src))
+			}
+			rows = append(rows, cells)
+		}
+
+		assert.Equal(t, [][]string{
+			{"Operator", "Meaning", "Example"},
+			{"|", "pipe", "a | b"},
+			{"||", "first<br>second", ""},
+		}, rows, flavor)
+	}
+
+	headless := &domain.EditorJSDataTable{Content: [][]string{{"a", "b"}, {"c", "d"}}}
+	doc, src := parseMarkdown(Table(headless))
+	assert.NotContains(t, kinds(doc), east.KindTable)                  // tables without headings keep their first row as data
+	assert.Equal(t, []string{"a", "b", "c", "d"}, htmlCells(doc, src)) // so they are kept as HTML
+
+	doc, src = parseMarkdown(New(CommonMark).Table(&domain.EditorJSDataTable{Content: [][]string{{"<b>bold</b> <script>alert(1)</script>", "- item"}}}))
+	assert.Equal(t, []string{"bold alert(1)", "- item"}, htmlCells(doc, src)) // cells are converted and escaped, unknown tags are dropped
+
+	assert.Equal(t, "", Table(&domain.EditorJSDataTable{WithHeadings: true}))
+}
+
+func TestRoundTripList(t *testing.T) {
+	list := &domain.EditorJSDataList{
+		Style: "ordered",
+		Items: []interface{}{
+			map[string]interface{}{
+				"content": "Cars<br>- and trucks",
+				"items": []interface{}{
+					map[string]interface{}{"content": "BMW<br>Z4", "items": []interface{}{}},
+					map[string]interface{}{"content": "# Audi", "items": []interface{}{}},
+				},
+			},
+			map[string]interface{}{"content": "Motorcycles", "items": []interface{}{}},
+		},
+	}
+
+	for _, style := range []string{"ordered", "unordered"} {
+		list.Style = style
+		output, err := List(list)
+		assert.NoError(t, err, style)
+
+		doc, src := parseMarkdown(output)
+
+		assert.Equal(t, []ast.NodeKind{ast.KindList}, kinds(doc), style)
+
+		outer := doc.FirstChild()
+		assert.Equal(t, 2, outer.ChildCount(), style)
+
+		first := outer.FirstChild()
+		assert.Equal(t, []ast.NodeKind{ast.KindTextBlock, ast.KindList}, kinds(first), style)
+		assert.Equal(t, "Cars\n- and trucks", plain(first.FirstChild(), src), style)
+
+		inner := first.LastChild()
+		assert.Equal(t, 2, inner.ChildCount(), style)
+		assert.Equal(t, "BMW\nZ4", plain(inner.FirstChild(), src), style)
+		assert.Equal(t, "# Audi", plain(inner.LastChild(), src), style)
+
+		assert.Equal(t, "Motorcycles", plain(outer.LastChild(), src), style)
+	}
+
+	// markers of four or more characters push the item content further in
+	var many []interface{}
+	for i := 0; i < 100; i++ {
+		many = append(many, map[string]interface{}{"content": "item", "items": []interface{}{}})
+	}
+	many[99] = map[string]interface{}{
+		"content": "hundred<br>continued",
+		"items":   []interface{}{map[string]interface{}{"content": "nested", "items": []interface{}{}}},
+	}
+
+	output, err := List(&domain.EditorJSDataList{Style: "ordered", Items: many})
+	assert.NoError(t, err)
+
+	doc, src := parseMarkdown(output)
+	last := doc.FirstChild().LastChild()
+	assert.Equal(t, []ast.NodeKind{ast.KindTextBlock, ast.KindList}, kinds(last))
+	assert.Equal(t, "hundred\ncontinued", plain(last.FirstChild(), src))
+	assert.Equal(t, "nested", plain(last.LastChild().FirstChild(), src))
+
+	checklist := &domain.EditorJSDataChecklist{Items: []domain.ChecklistItem{
+		{Text: "first<br>> continued", Checked: true},
+		{Text: "second"},
+	}}
+
+	doc, src = parseMarkdown(Checklist(checklist))
+	assert.Equal(t, []ast.NodeKind{ast.KindList}, kinds(doc))
+	assert.Equal(t, 2, doc.FirstChild().ChildCount())
+	assert.Equal(t, "first\n> continued", plain(doc.FirstChild().FirstChild(), src))
+}
+
+func TestRoundTripCode(t *testing.T) {
+	code := "fmt.Println(`a`)\n```\nstill code\n````"
+
+	doc, src := parseMarkdown(Code(&domain.EditorJSDataCode{Code: code, LanguageCode: "go"}))
+	assert.Equal(t, []ast.NodeKind{ast.KindFencedCodeBlock}, kinds(doc)) // the fence is longer than any backtick run
+
+	block := doc.FirstChild().(*ast.FencedCodeBlock)
+	var lines []string
+	for i := 0; i < block.Lines().Len(); i++ {
+		segment := block.Lines().At(i)
+		lines = append(lines, strings.TrimSuffix(string(segment.Value(src)), "\n"))
+	}
+	assert.Equal(t, code, strings.Join(lines, "\n"))
+	assert.Equal(t, "go", string(block.Language(src)))
+}
+
+func TestRoundTripLinks(t *testing.T) {
+	destinations := func(source string) []string {
+		doc, _ := parseMarkdown(source)
+
+		var result []string
+		ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+			if !entering {
+				return ast.WalkContinue, nil
+			}
+			switch n := node.(type) {
+			case *ast.Link:
+				result = append(result, string(n.Destination))
+			case *ast.Image:
+				result = append(result, string(n.Destination))
+			}
+			return ast.WalkContinue, nil
+		})
+
+		return result
+	}
+
+	url := "https://example.com/a_(b) c"
+	escaped := "https://example.com/a_%28b%29%20c"
+
+	assert.Equal(t, []string{"", escaped}, destinations(LinkTool(&domain.EditorJSDataLinkTool{Link: url})))
+	assert.Equal(t, []string{escaped}, destinations(Attaches(&domain.EditorJSDataAttaches{File: domain.FileData{URL: url}})))
+	assert.Equal(t, []string{escaped}, destinations(Embed(&domain.EditorJSDataEmbed{Service: "my_[service]", Source: url})))
+	assert.Equal(t, []string{escaped, escaped}, destinations(ImageGallery(&domain.EditorJSDataImageGallery{URLs: []string{url, url}})))
+
+	doc, src := parseMarkdown(LinkTool(&domain.EditorJSDataLinkTool{Link: "https://example.com/*a*_b_"}))
+	link := doc.LastChild().PreviousSibling().FirstChild()
+	assert.Equal(t, ast.KindLink, link.Kind())
+	assert.Equal(t, "example.com/*a*_b_", plain(link, src)) // link text is escaped
+}
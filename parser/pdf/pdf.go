@@ -0,0 +1,637 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"github.com/go-pdf/fpdf"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type Color [3]int
+
+type Theme struct {
+	PageSize    string
+	Margin      float64
+	FontFamily  string
+	MonoFamily  string
+	FontSize    float64
+	LineHeight  float64
+	HeaderSizes [6]float64
+	Text        Color
+	Muted       Color
+	Link        Color
+	Accent      Color
+	CodeFill    Color
+	TableFill   Color
+	BlockGap    float64
+}
+
+var DefaultTheme = Theme{
+	PageSize:    "A4",
+	Margin:      20,
+	FontFamily:  "Helvetica",
+	MonoFamily:  "Courier",
+	FontSize:    11,
+	LineHeight:  1.4,
+	HeaderSizes: [6]float64{24, 20, 17, 15, 13, 12},
+	Text:        Color{33, 37, 41},
+	Muted:       Color{108, 117, 125},
+	Link:        Color{13, 110, 253},
+	Accent:      Color{255, 193, 7},
+	CodeFill:    Color{244, 245, 247},
+	TableFill:   Color{233, 236, 239},
+	BlockGap:    4,
+}
+
+type Renderer struct {
+	Theme Theme
+	// Images resolves relative image sources. Absolute paths and paths that
+	// leave its root aren't read; they, remote images and every image when
+	// Images is nil are replaced by a link.
+	Images fs.FS
+	Limits support.Limits
+}
+
+func New() Renderer {
+	return Renderer{Theme: DefaultTheme}
+}
+
+type document struct {
+	Renderer
+	pdf    *fpdf.Fpdf
+	tr     func(string) string
+	images int
+}
+
+func (r Renderer) Render(jsonStr string, w io.Writer) error {
+	editorJSON, err := support.ParseEditorJSONWithLimits(jsonStr, r.Limits)
+	if err != nil {
+		return err
+	}
+
+	if r.Theme.FontFamily == "" {
+		r.Theme = DefaultTheme
+	}
+
+	t := r.Theme
+	pdf := fpdf.New("P", "mm", t.PageSize, "")
+	pdf.SetMargins(t.Margin, t.Margin, t.Margin)
+	pdf.SetAutoPageBreak(true, t.Margin)
+
+	d := &document{Renderer: r, pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-t.Margin / 2)
+		pdf.SetFont(t.FontFamily, "", t.FontSize*0.8)
+		d.color(t.Muted)
+		pdf.CellFormat(0, 4, strconv.Itoa(pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	pdf.AddPage()
+
+	for _, el := range editorJSON.Blocks {
+		if err = support.CheckBlockLimits(el, r.Limits); err != nil {
+			return err
+		}
+
+		d.reset()
+
+		if _, _, err = support.RenderBlock(el.Type, support.PrepareData(el), blocks{d}); err != nil {
+			return err
+		}
+
+		if err = pdf.Error(); err != nil {
+			return err
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+// blocks draws each block on the document; support.RenderBlock picks the
+// method for the block type.
+type blocks struct {
+	d *document
+}
+
+func (b blocks) Header(el *domain.EditorJSDataHeader) string {
+	b.d.header(el)
+	return ""
+}
+
+func (b blocks) Paragraph(el *domain.EditorJSDataParagraph) string {
+	b.d.paragraph(el)
+	return ""
+}
+
+func (b blocks) Quote(el *domain.EditorJSDataQuote) string {
+	b.d.quote(el)
+	return ""
+}
+
+func (b blocks) Warning(el *domain.EditorJSDataWarning) string {
+	b.d.callout(el.Title, el.Message, b.d.Theme.Accent)
+	return ""
+}
+
+func (b blocks) Delimiter() string {
+	b.d.delimiter()
+	return ""
+}
+
+func (b blocks) Alert(el *domain.EditorJSDataAlert) string {
+	b.d.callout("", el.Message, b.d.Theme.Accent)
+	return ""
+}
+
+func (b blocks) List(el *domain.EditorJSDataList) (string, error) {
+	return "", b.d.list(el)
+}
+
+func (b blocks) Checklist(el *domain.EditorJSDataChecklist) string {
+	b.d.checklist(el)
+	return ""
+}
+
+func (b blocks) Table(el *domain.EditorJSDataTable) string {
+	b.d.table(el)
+	return ""
+}
+
+func (b blocks) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	b.d.inline(el.Text, el.Link)
+	return ""
+}
+
+func (b blocks) Code(el *domain.EditorJSDataCode) string {
+	b.d.code(el.Code)
+	return ""
+}
+
+func (b blocks) Raw(el *domain.EditorJSDataRaw) string {
+	b.d.code(el.Html)
+	return ""
+}
+
+func (b blocks) Image(el *domain.EditorJSDataImage) string {
+	url := el.File.URL
+	if url == "" {
+		url = el.URL
+	}
+	b.d.image(url, el.Caption)
+	return ""
+}
+
+func (b blocks) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	b.d.linkTool(el)
+	return ""
+}
+
+func (b blocks) Attaches(el *domain.EditorJSDataAttaches) string {
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+	b.d.inline(name+" ("+support.HumanFileSize(el.File.Size)+")", el.File.URL)
+	return ""
+}
+
+func (b blocks) Embed(el *domain.EditorJSDataEmbed) string {
+	if el.Caption != "" {
+		b.d.inline(el.Caption, "")
+	}
+	b.d.inline("Watch on "+el.Service, el.Source)
+	return ""
+}
+
+func (b blocks) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	for _, url := range el.URLs {
+		b.d.image(url, "")
+	}
+	return ""
+}
+
+func (d *document) reset() {
+	t := d.Theme
+	d.pdf.SetLeftMargin(t.Margin)
+	d.pdf.SetX(t.Margin)
+	d.pdf.SetFont(t.FontFamily, "", t.FontSize)
+	d.color(t.Text)
+}
+
+func (d *document) color(c Color) {
+	d.pdf.SetTextColor(c[0], c[1], c[2])
+}
+
+func (d *document) lineHeight(size float64) float64 {
+	return size * d.Theme.LineHeight * 25.4 / 72
+}
+
+func (d *document) gap() {
+	d.pdf.Ln(d.Theme.BlockGap)
+}
+
+func (d *document) contentWidth() float64 {
+	width, _ := d.pdf.GetPageSize()
+	left, _, right, _ := d.pdf.GetMargins()
+	return width - left - right
+}
+
+func (d *document) header(el *domain.EditorJSDataHeader) {
+	level := el.Level
+	if level < 1 || level > 6 {
+		level = 2
+	}
+
+	size := d.Theme.HeaderSizes[level-1]
+	d.pdf.Ln(d.Theme.BlockGap / 2)
+	d.pdf.SetFont(d.Theme.FontFamily, "B", size)
+	d.write(inline.Parse(el.Text), "B", size, "")
+	d.pdf.Ln(d.lineHeight(size))
+	d.gap()
+}
+
+func (d *document) paragraph(el *domain.EditorJSDataParagraph) {
+	if el.Alignment == "center" || el.Alignment == "right" {
+		align := "C"
+		if el.Alignment == "right" {
+			align = "R"
+		}
+		d.pdf.MultiCell(0, d.lineHeight(d.Theme.FontSize), d.tr(inline.PlainText(inline.Parse(el.Text))), "", align, false)
+		d.gap()
+		return
+	}
+
+	d.inline(el.Text, "")
+}
+
+// inline writes a paragraph of Editor.js inline markup; with href the whole
+// text becomes a link.
+func (d *document) inline(text, href string) {
+	nodes := inline.Parse(text)
+	if href != "" {
+		nodes = []inline.Node{{Kind: inline.Link, Href: href, Children: nodes}}
+	}
+
+	d.write(nodes, "", d.Theme.FontSize, "")
+	d.pdf.Ln(d.lineHeight(d.Theme.FontSize))
+	d.gap()
+}
+
+func (d *document) write(nodes []inline.Node, style string, size float64, href string) {
+	t := d.Theme
+	h := d.lineHeight(size)
+
+	for _, node := range nodes {
+		switch node.Kind {
+		case inline.Text:
+			d.pdf.SetFont(t.FontFamily, style, size)
+			if href != "" {
+				d.color(t.Link)
+				d.pdf.WriteLinkString(h, d.tr(node.Text), href)
+				d.color(t.Text)
+			} else {
+				d.pdf.Write(h, d.tr(node.Text))
+			}
+		case inline.Break:
+			d.pdf.Ln(h)
+		case inline.Code:
+			d.pdf.SetFont(t.MonoFamily, "", size)
+			d.pdf.Write(h, d.tr(inline.PlainText(node.Children)))
+		case inline.Bold:
+			d.write(node.Children, addStyle(style, "B"), size, href)
+		case inline.Italic:
+			d.write(node.Children, addStyle(style, "I"), size, href)
+		case inline.Underline:
+			d.write(node.Children, addStyle(style, "U"), size, href)
+		case inline.Link:
+			d.write(node.Children, addStyle(style, "U"), size, node.Href)
+		default:
+			d.write(node.Children, style, size, href)
+		}
+	}
+
+	d.pdf.SetFont(t.FontFamily, style, size)
+}
+
+func addStyle(style, add string) string {
+	if strings.Contains(style, add) {
+		return style
+	}
+	return style + add
+}
+
+func (d *document) quote(el *domain.EditorJSDataQuote) {
+	t := d.Theme
+	top := d.pdf.GetY()
+
+	d.pdf.SetLeftMargin(t.Margin + 6)
+	d.pdf.SetX(t.Margin + 6)
+	d.write(inline.Parse(el.Text), "I", t.FontSize, "")
+	d.pdf.Ln(d.lineHeight(t.FontSize))
+
+	if el.Caption != "" {
+		d.color(t.Muted)
+		d.pdf.Write(d.lineHeight(t.FontSize), d.tr("— "))
+		d.write(inline.Parse(el.Caption), "", t.FontSize, "")
+		d.pdf.Ln(d.lineHeight(t.FontSize))
+	}
+
+	d.bar(top, t.Muted)
+	d.reset()
+	d.gap()
+}
+
+func (d *document) callout(title, message string, accent Color) {
+	t := d.Theme
+	top := d.pdf.GetY()
+
+	d.pdf.SetLeftMargin(t.Margin + 6)
+	d.pdf.SetX(t.Margin + 6)
+
+	if title != "" {
+		d.write(inline.Parse(title), "B", t.FontSize, "")
+		d.pdf.Ln(d.lineHeight(t.FontSize))
+	}
+
+	d.write(inline.Parse(message), "", t.FontSize, "")
+	d.pdf.Ln(d.lineHeight(t.FontSize))
+
+	d.bar(top, accent)
+	d.reset()
+	d.gap()
+}
+
+// bar draws the vertical rule on the left of quotes and callouts, unless the
+// block was split across pages.
+func (d *document) bar(top float64, c Color) {
+	bottom := d.pdf.GetY()
+	if bottom <= top {
+		return
+	}
+
+	d.pdf.SetDrawColor(c[0], c[1], c[2])
+	d.pdf.SetLineWidth(1)
+	d.pdf.Line(d.Theme.Margin+1, top, d.Theme.Margin+1, bottom)
+	d.pdf.SetLineWidth(0.2)
+}
+
+func (d *document) delimiter() {
+	t := d.Theme
+	y := d.pdf.GetY() + t.BlockGap
+	width := d.contentWidth()
+
+	d.pdf.SetDrawColor(t.Muted[0], t.Muted[1], t.Muted[2])
+	d.pdf.Line(t.Margin+width/3, y, t.Margin+width*2/3, y)
+	d.pdf.SetY(y + t.BlockGap)
+	d.gap()
+}
+
+func (d *document) list(el *domain.EditorJSDataList) error {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return err
+	}
+
+	d.listItems(itemsList, el.Style == "ordered", 0)
+	d.reset()
+	d.gap()
+	return nil
+}
+
+func (d *document) listItems(items []domain.NestedListItem, ordered bool, depth int) {
+	t := d.Theme
+	h := d.lineHeight(t.FontSize)
+	indent := t.Margin + float64(depth)*6
+
+	for i, item := range items {
+		marker := "•"
+		if ordered {
+			marker = strconv.Itoa(i+1) + "."
+		}
+
+		d.pdf.SetLeftMargin(indent)
+		d.pdf.SetX(indent)
+		d.pdf.SetFont(t.FontFamily, "", t.FontSize)
+		d.pdf.CellFormat(6, h, d.tr(marker), "", 0, "L", false, 0, "")
+
+		d.pdf.SetLeftMargin(indent + 6)
+		d.write(inline.Parse(item.Content), "", t.FontSize, "")
+		d.pdf.Ln(h)
+
+		d.listItems(item.Items, ordered, depth+1)
+	}
+}
+
+func (d *document) checklist(el *domain.EditorJSDataChecklist) {
+	t := d.Theme
+	h := d.lineHeight(t.FontSize)
+	box := t.FontSize * 0.3
+
+	for _, item := range el.Items {
+		x, y := t.Margin, d.pdf.GetY()+(h-box)/2
+
+		d.pdf.SetDrawColor(t.Text[0], t.Text[1], t.Text[2])
+		d.pdf.Rect(x, y, box, box, "D")
+		if item.Checked {
+			d.pdf.Line(x+box*0.2, y+box*0.55, x+box*0.45, y+box*0.8)
+			d.pdf.Line(x+box*0.45, y+box*0.8, x+box*0.85, y+box*0.2)
+		}
+
+		d.pdf.SetLeftMargin(t.Margin + box + 3)
+		d.pdf.SetX(t.Margin + box + 3)
+		d.write(inline.Parse(item.Text), "", t.FontSize, "")
+		d.pdf.Ln(h)
+	}
+
+	d.reset()
+	d.gap()
+}
+
+func (d *document) table(el *domain.EditorJSDataTable) {
+	t := d.Theme
+	h := d.lineHeight(t.FontSize)
+
+	columns := 0
+	for _, row := range el.Content {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	if columns == 0 {
+		return
+	}
+
+	width := d.contentWidth() / float64(columns)
+	_, pageHeight := d.pdf.GetPageSize()
+
+	d.pdf.SetDrawColor(t.Muted[0], t.Muted[1], t.Muted[2])
+	d.pdf.SetFillColor(t.TableFill[0], t.TableFill[1], t.TableFill[2])
+
+	for index, row := range el.Content {
+		style := ""
+		if el.WithHeadings && index == 0 {
+			style = "B"
+		}
+		d.pdf.SetFont(t.FontFamily, style, t.FontSize)
+
+		var cells [][]string
+		lines := 1
+		for column := 0; column < columns; column++ {
+			text := ""
+			if column < len(row) {
+				text = d.tr(inline.PlainText(inline.Parse(row[column])))
+			}
+			cell := d.pdf.SplitText(text, width-2)
+			if len(cell) > lines {
+				lines = len(cell)
+			}
+			cells = append(cells, cell)
+		}
+
+		rowHeight := float64(lines) * h
+		if d.pdf.GetY()+rowHeight > pageHeight-t.Margin {
+			d.pdf.AddPage()
+		}
+
+		border := "D"
+		if style == "B" {
+			border = "FD"
+		}
+
+		y := d.pdf.GetY()
+		for column, cell := range cells {
+			x := t.Margin + float64(column)*width
+			d.pdf.Rect(x, y, width, rowHeight, border)
+			d.pdf.SetXY(x, y)
+			d.pdf.MultiCell(width, h, strings.Join(cell, "\n"), "", "L", false)
+		}
+
+		d.pdf.SetXY(t.Margin, y+rowHeight)
+	}
+
+	d.gap()
+}
+
+func (d *document) code(code string) {
+	t := d.Theme
+	size := t.FontSize * 0.9
+
+	d.pdf.SetFont(t.MonoFamily, "", size)
+	d.pdf.SetFillColor(t.CodeFill[0], t.CodeFill[1], t.CodeFill[2])
+	d.pdf.MultiCell(0, d.lineHeight(size), d.tr(strings.ReplaceAll(code, "\t", "    ")), "", "L", true)
+	d.gap()
+}
+
+func (d *document) image(url, caption string) {
+	t := d.Theme
+
+	imageType := strings.TrimPrefix(strings.ToLower(path.Ext(url)), ".")
+	if imageType == "jpeg" {
+		imageType = "jpg"
+	}
+
+	var content []byte
+	var err error
+
+	if imageType == "jpg" || imageType == "png" || imageType == "gif" {
+		content, err = d.readImage(url)
+	}
+
+	if content != nil && err == nil {
+		// the content decides the type, and broken files are linked instead
+		// of failing the document
+		var format string
+		if _, format, err = image.DecodeConfig(bytes.NewReader(content)); err == nil {
+			imageType = strings.Replace(format, "jpeg", "jpg", 1)
+		}
+	}
+
+	if content == nil || err != nil {
+		d.imageLink(url, caption)
+		return
+	}
+
+	d.images++
+	name := "image-" + strconv.Itoa(d.images)
+	options := fpdf.ImageOptions{ImageType: imageType, ReadDpi: true}
+	info := d.pdf.RegisterImageOptionsReader(name, options, bytes.NewReader(content))
+	if info == nil || d.pdf.Err() {
+		d.pdf.ClearError()
+		d.imageLink(url, caption)
+		return
+	}
+
+	width := info.Width()
+	if maxWidth := d.contentWidth(); width > maxWidth {
+		width = maxWidth
+	}
+
+	d.pdf.ImageOptions(name, t.Margin, d.pdf.GetY(), width, 0, true, options, 0, "")
+
+	if caption != "" {
+		d.pdf.Ln(1)
+		d.color(t.Muted)
+		d.pdf.SetFont(t.FontFamily, "I", t.FontSize*0.9)
+		d.write(inline.Parse(caption), "I", t.FontSize*0.9, "")
+		d.pdf.Ln(d.lineHeight(t.FontSize * 0.9))
+	}
+
+	d.gap()
+}
+
+func (d *document) imageLink(url, caption string) {
+	label := inline.PlainText(inline.Parse(caption))
+	if label == "" {
+		label = url
+	}
+	d.color(d.Theme.Muted)
+	d.inline("[Image: "+label+"]", url)
+}
+
+func (d *document) readImage(url string) ([]byte, error) {
+	if d.Images == nil || strings.Contains(url, "://") || strings.HasPrefix(url, "//") {
+		return nil, fmt.Errorf("editorjs: image %s is not local", url)
+	}
+
+	name, err := support.LocalPath(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.ReadFile(d.Images, name)
+}
+
+func (d *document) linkTool(el *domain.EditorJSDataLinkTool) {
+	t := d.Theme
+	title := el.Meta.Title
+	if title == "" {
+		title = el.Link
+	}
+
+	d.write([]inline.Node{{Kind: inline.Bold, Children: inline.Parse(title)}}, "", t.FontSize, el.Link)
+	d.pdf.Ln(d.lineHeight(t.FontSize))
+
+	if el.Meta.Description != "" {
+		d.write(inline.Parse(el.Meta.Description), "", t.FontSize, "")
+		d.pdf.Ln(d.lineHeight(t.FontSize))
+	}
+
+	d.color(t.Muted)
+	d.pdf.SetFont(t.FontFamily, "", t.FontSize*0.9)
+	d.pdf.Write(d.lineHeight(t.FontSize*0.9), d.tr(strings.ReplaceAll(strings.ReplaceAll(el.Link, "https://", ""), "http://", "")))
+	d.pdf.Ln(d.lineHeight(t.FontSize * 0.9))
+	d.gap()
+}
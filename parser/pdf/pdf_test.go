@@ -0,0 +1,125 @@
+package pdf
+
+import (
+	"bytes"
+	"github.com/matryer/is"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const allBlocks = `{
+    "blocks": [
+        {"type": "header", "data": {"text": "Report <i>2024</i>", "level": 1}},
+        {"type": "paragraph", "data": {"text": "Text with <b>bold</b>, <i>italic</i>, <code>code</code> and a <a href=\"https://codex.so\">link</a>.<br>Second line, café."}},
+        {"type": "paragraph", "data": {"text": "Centered", "alignment": "center"}},
+        {"type": "quote", "data": {"text": "Quoted", "caption": "Author"}},
+        {"type": "warning", "data": {"title": "Note", "message": "Careful"}},
+        {"type": "alert", "data": {"type": "info", "message": "Heads up"}},
+        {"type": "delimiter", "data": {}},
+        {"type": "list", "data": {"style": "ordered", "items": [{"content": "One", "items": [{"content": "Nested", "items": []}]}, {"content": "Two", "items": []}]}},
+        {"type": "list", "data": {"style": "unordered", "items": ["Plain", "Items"]}},
+        {"type": "checklist", "data": {"items": [{"text": "Done", "checked": true}, {"text": "Todo", "checked": false}]}},
+        {"type": "table", "data": {"withHeadings": true, "content": [["Name", "Value"], ["A long cell that wraps over several lines in the rendered table", "1"], ["Short"]]}},
+        {"type": "code", "data": {"code": "func main() {\n\tprintln(\"hi\")\n}", "languageCode": "go"}},
+        {"type": "raw", "data": {"html": "<div>raw</div>"}},
+        {"type": "image", "data": {"file": {"url": "img/dot.png"}, "caption": "A dot"}},
+        {"type": "image", "data": {"file": {"url": "https://example.com/remote.png"}, "caption": "Remote"}},
+        {"type": "linkTool", "data": {"link": "https://codex.so", "meta": {"title": "CodeX", "description": "Team"}}},
+        {"type": "attaches", "data": {"file": {"url": "https://example.com/a.pdf", "size": 2048, "name": "a.pdf"}}},
+        {"type": "embed", "data": {"service": "youtube", "source": "https://youtube.com/watch?v=x", "caption": "Video"}},
+        {"type": "imageGallery", "data": {"urls": ["img/dot.png"]}},
+        {"type": "AnyButton", "data": {"link": "https://codex.so", "text": "Open"}}
+    ]
+}`
+
+func dotPNG(t *testing.T) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 8; y++ {
+			img.Set(x, y, color.RGBA{R: 200, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestRenderAllBlocks(t *testing.T) {
+	is := is.New(t)
+
+	r := New()
+	r.Images = fstest.MapFS{"img/dot.png": &fstest.MapFile{Data: dotPNG(t)}}
+
+	var out bytes.Buffer
+	is.NoErr(r.Render(allBlocks, &out))
+
+	is.True(strings.HasPrefix(out.String(), "%PDF-"))
+	is.True(strings.Contains(out.String(), "/Subtype /Image")) // local images are embedded
+	is.True(strings.Contains(out.String(), "/URI"))            // links are clickable
+}
+
+func TestRenderPageBreaks(t *testing.T) {
+	is := is.New(t)
+
+	var blocks []string
+	for i := 0; i < 120; i++ {
+		blocks = append(blocks, `{"type": "paragraph", "data": {"text": "Paragraph long enough to need a line of its own."}}`)
+	}
+
+	var out bytes.Buffer
+	is.NoErr(New().Render(`{"blocks": [`+strings.Join(blocks, ",")+`]}`, &out))
+
+	is.True(strings.Count(out.String(), "/Type /Page\n") > 1) // content flows onto new pages
+}
+
+func TestRenderInvalidInput(t *testing.T) {
+	is := is.New(t)
+
+	var out bytes.Buffer
+	is.True(New().Render(`{"blocks": {}}`, &out) != nil)
+}
+
+func TestImagesStayInRoot(t *testing.T) {
+	is := is.New(t)
+
+	r := New()
+	r.Images = fstest.MapFS{"img/dot.png": &fstest.MapFile{Data: dotPNG(t)}}
+
+	for _, src := range []string{"/img/dot.png", "../img/dot.png", "img/../../img/dot.png", "file:///img/dot.png"} {
+		var out bytes.Buffer
+		is.NoErr(r.Render(`{"blocks": [{"type": "image", "data": {"file": {"url": "`+src+`"}}}]}`, &out))
+		is.True(!strings.Contains(out.String(), "/Subtype /Image")) // only relative paths inside Images are read
+	}
+
+	var out bytes.Buffer
+	is.NoErr(New().Render(`{"blocks": [{"type": "image", "data": {"file": {"url": "img/dot.png"}}}]}`, &out))
+	is.True(!strings.Contains(out.String(), "/Subtype /Image")) // nothing is read without Images
+}
+
+func TestCorruptImagesBecomeLinks(t *testing.T) {
+	is := is.New(t)
+
+	r := New()
+	r.Images = fstest.MapFS{
+		"img/broken.png": &fstest.MapFile{Data: []byte("not a png")},
+		"img/dot.jpg":    &fstest.MapFile{Data: dotPNG(t)},
+	}
+
+	var out bytes.Buffer
+	is.NoErr(r.Render(`{"blocks": [
+		{"type": "image", "data": {"file": {"url": "img/broken.png"}, "caption": "Broken"}},
+		{"type": "paragraph", "data": {"text": "After"}},
+		{"type": "imageGallery", "data": {"urls": ["img/dot.jpg"]}}
+	]}`, &out))
+
+	is.True(strings.Contains(out.String(), "/URI (img/broken.png)")) // the broken image is linked
+	is.True(strings.Contains(out.String(), "/Subtype /Image"))       // a misnamed image is embedded by its content
+}
@@ -0,0 +1,122 @@
+package rst
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`*`, `\*`,
+	"`", "\\`",
+	`_`, `\_`,
+	`|`, `\|`,
+	`<`, `\<`,
+)
+
+var urlEscaper = strings.NewReplacer(
+	` `, `%20`,
+	`<`, `%3C`,
+	`>`, `%3E`,
+	"`", `%60`,
+)
+
+// The boundaries mark the edges of inline markup until it is known whether
+// the markup touches a word, which reStructuredText only allows through an
+// escaped space.
+const (
+	openBoundary  = "\x00"
+	closeBoundary = "\x01"
+)
+
+// lineStartPattern matches the line starts reStructuredText reads as block
+// syntax: list markers, enumerators, field lists, directives and section
+// adornments.
+var lineStartPattern = regexp.MustCompile(`^([-+:>=~^'"#.]|\d+[.)]|\(?[A-Za-z]\)|\(\d+\))`)
+
+var markup = inline.Markup{
+	Escape: Escape,
+	Delimiters: map[inline.Kind][2]string{
+		inline.Bold:   {"**", "**"},
+		inline.Italic: {"*", "*"},
+	},
+	Code: func(text string) string {
+		text = strings.ReplaceAll(text, "\n", " ")
+		if strings.Contains(text, "``") || strings.TrimSpace(text) != text {
+			return ":literal:`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(text) + "`"
+		}
+		return "``" + text + "``"
+	},
+	Link: func(inner, href string) string {
+		return "`" + inner + " <" + urlEscaper.Replace(href) + ">`__"
+	},
+	Break:      "\n",
+	Boundaries: [2]string{openBoundary, closeBoundary},
+	Flat:       true,
+}
+
+// Escape protects reStructuredText markup characters in plain text.
+func Escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// Inline converts the inline HTML of Editor.js text into reStructuredText.
+func Inline(s string) string {
+	return boundaries(markup.Convert(s))
+}
+
+// boundaries drops the markup boundaries where the markup is already
+// delimited by whitespace, punctuation or the edges of the text, and turns
+// the others into escaped spaces.
+func boundaries(s string) string {
+	var result strings.Builder
+
+	for i, r := range s {
+		switch string(r) {
+		case openBoundary:
+			previous, _ := utf8.DecodeLastRuneInString(result.String())
+			if result.Len() > 0 && !delimits(previous, `-:/'"<([{`) {
+				result.WriteString(`\ `)
+			}
+		case closeBoundary:
+			next, _ := utf8.DecodeRuneInString(strings.TrimLeft(s[i:], openBoundary+closeBoundary))
+			if next != utf8.RuneError && !delimits(next, `-.,:;!?\/'")]}>`) {
+				result.WriteString(`\ `)
+			}
+		default:
+			result.WriteRune(r)
+		}
+	}
+
+	return result.String()
+}
+
+// delimits reports whether r may sit next to inline markup: whitespace, the
+// given ASCII punctuation or any non-ASCII punctuation.
+func delimits(r rune, ascii string) bool {
+	if r < utf8.RuneSelf {
+		return unicode.IsSpace(r) || strings.ContainsRune(ascii, r)
+	}
+	return unicode.IsSpace(r) || unicode.IsPunct(r)
+}
+
+// block converts text that fills whole lines, writing text with line breaks
+// as a line block and keeping lines from being read as block syntax.
+func block(s string) string {
+	lines := strings.Split(Inline(s), "\n")
+
+	for i, line := range lines {
+		if lineStartPattern.MatchString(line) {
+			line = `\` + line
+		}
+		if len(lines) > 1 {
+			line = "| " + line
+		}
+		lines[i] = line
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,292 @@
+package rst
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"strings"
+)
+
+type Renderer struct{}
+
+// Section adornments by header level, in the order Sphinx documents use them.
+// docutils assigns levels in the order adornments appear, so documents should
+// not skip header levels.
+var adornments = []string{"=", "-", "~", "^", `"`, "'"}
+
+var admonitions = map[string]string{
+	"success": "tip",
+	"warning": "warning",
+	"danger":  "danger",
+	"primary": "important",
+}
+
+const indent = "   "
+
+func (r Renderer) Header(el *domain.EditorJSDataHeader) string {
+	level := el.Level
+	if level < 1 || level > len(adornments) {
+		level = 2
+	}
+
+	title := strings.ReplaceAll(Inline(el.Text), "\n", " ")
+	if lineStartPattern.MatchString(title) {
+		title = `\` + title
+	}
+
+	// The adornment must be at least as wide as the title; the byte length
+	// always is, even for wide characters.
+	header := title + "\n" + strings.Repeat(adornments[level-1], len(title))
+
+	if el.Anchor != "" {
+		header = ".. _" + anchorID(el.Anchor) + ":\n\n" + header
+	}
+
+	return header
+}
+
+func (r Renderer) Paragraph(el *domain.EditorJSDataParagraph) string {
+	return block(el.Text)
+}
+
+func (r Renderer) Quote(el *domain.EditorJSDataQuote) string {
+	quote := indentLines(block(el.Text), indent)
+
+	if el.Caption != "" {
+		quote += "\n\n" + indent + "-- " + strings.ReplaceAll(Inline(el.Caption), "\n", " ")
+	}
+
+	// An empty comment keeps the indented quote from continuing the
+	// previous block.
+	return "..\n\n" + quote
+}
+
+func (r Renderer) Warning(el *domain.EditorJSDataWarning) string {
+	if el.Title == "" {
+		return ".. warning::\n\n" + indentLines(block(el.Message), indent)
+	}
+
+	title := strings.ReplaceAll(Inline(el.Title), "\n", " ")
+
+	return ".. admonition:: " + title + "\n" + indent + ":class: warning\n\n" + indentLines(block(el.Message), indent)
+}
+
+func (r Renderer) Delimiter() string {
+	return "----"
+}
+
+func (r Renderer) Alert(el *domain.EditorJSDataAlert) string {
+	kind, ok := admonitions[el.Type]
+	if !ok {
+		kind = "note"
+	}
+
+	return ".. " + kind + "::\n\n" + indentLines(block(el.Message), indent)
+}
+
+func (r Renderer) List(el *domain.EditorJSDataList) (string, error) {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return "", err
+	}
+
+	marker := "#."
+	if el.Style == "unordered" {
+		marker = "-"
+	}
+
+	return nestedList(itemsList, marker), nil
+}
+
+// nestedList separates nested lists from their parent item with blank lines,
+// which reStructuredText requires.
+func nestedList(items []domain.NestedListItem, marker string) string {
+	var result []string
+
+	continuation := strings.Repeat(" ", len(marker)+1)
+
+	for _, item := range items {
+		result = append(result, marker+" "+indentContinuation(block(item.Content), continuation))
+
+		if len(item.Items) > 0 {
+			result = append(result, "\n"+indentLines(nestedList(item.Items, marker), continuation)+"\n")
+		}
+	}
+
+	return strings.TrimSuffix(strings.Join(result, "\n"), "\n")
+}
+
+// Checklist uses ballot box characters, as reStructuredText has no task
+// lists.
+func (r Renderer) Checklist(el *domain.EditorJSDataChecklist) string {
+	var result []string
+
+	for _, item := range el.Items {
+		box := "☐"
+		if item.Checked {
+			box = "☑"
+		}
+		result = append(result, "- "+box+" "+indentContinuation(block(item.Text), "  "))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Table(el *domain.EditorJSDataTable) string {
+	columns := 0
+	for _, line := range el.Content {
+		if len(line) > columns {
+			columns = len(line)
+		}
+	}
+
+	if columns == 0 {
+		return ""
+	}
+
+	var result []string
+
+	result = append(result, ".. list-table::")
+	if el.WithHeadings {
+		result = append(result, indent+":header-rows: 1")
+	}
+	result = append(result, "")
+
+	for _, line := range el.Content {
+		for column := 0; column < columns; column++ {
+			marker := indent + "  - "
+			if column == 0 {
+				marker = indent + "* - "
+			}
+
+			info := ""
+			if column < len(line) {
+				info = indentContinuation(block(line[column]), indent+"    ")
+			}
+
+			result = append(result, strings.TrimRight(marker+info, " "))
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return "`" + Escape(inline.PlainText(inline.Parse(el.Text))) + " <" + urlEscaper.Replace(el.Link) + ">`__"
+}
+
+func (r Renderer) Code(el *domain.EditorJSDataCode) string {
+	if el.LanguageCode == "" {
+		return "::\n\n" + indentLines(el.Code, indent)
+	}
+
+	return ".. code-block:: " + el.LanguageCode + "\n\n" + indentLines(el.Code, indent)
+}
+
+func (r Renderer) Raw(el *domain.EditorJSDataRaw) string {
+	return ".. raw:: html\n\n" + indentLines(el.Html, indent)
+}
+
+func (r Renderer) Image(el *domain.EditorJSDataImage) string {
+	url := el.URL
+	if el.File.URL != "" {
+		url = el.File.URL
+	}
+
+	var options []string
+
+	if el.Caption != "" {
+		options = append(options, indent+":alt: "+strings.ReplaceAll(inline.PlainText(inline.Parse(el.Caption)), "\n", " "))
+	}
+	if el.Stretched {
+		options = append(options, indent+":width: 100%")
+	}
+
+	if el.Caption == "" {
+		return strings.Join(append([]string{".. image:: " + url}, options...), "\n")
+	}
+
+	figure := append([]string{".. figure:: " + url}, options...)
+
+	return strings.Join(figure, "\n") + "\n\n" + indentLines(block(el.Caption), indent)
+}
+
+// LinkTool renders the link card as a topic titled with the link, holding the
+// preview image and the description.
+func (r Renderer) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	title := inline.PlainText(inline.Parse(el.Meta.Title))
+	if title == "" {
+		title = el.Link
+	}
+
+	var result []string
+
+	result = append(result, ".. topic:: `"+Escape(title)+" <"+urlEscaper.Replace(el.Link)+">`__")
+
+	if el.Meta.Image.URL != "" {
+		result = append(result, "", indent+".. image:: "+el.Meta.Image.URL)
+	}
+
+	if el.Meta.Description != "" {
+		result = append(result, "", indentLines(block(el.Meta.Description), indent))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Attaches(el *domain.EditorJSDataAttaches) string {
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+
+	return "`" + Escape(name) + " <" + urlEscaper.Replace(el.File.URL) + ">`__ (" + support.HumanFileSize(el.File.Size) + ")"
+}
+
+func (r Renderer) Embed(el *domain.EditorJSDataEmbed) string {
+	link := "`Watch on " + Escape(el.Service) + " <" + urlEscaper.Replace(el.Source) + ">`__"
+
+	if el.Caption != "" {
+		return "| " + strings.ReplaceAll(Inline(el.Caption), "\n", " ") + "\n| " + link
+	}
+
+	return link
+}
+
+func (r Renderer) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	var images []string
+
+	for _, url := range el.URLs {
+		images = append(images, ".. image:: "+url)
+	}
+
+	return strings.Join(images, "\n\n")
+}
+
+// indentLines indents every non-empty line.
+func indentLines(text, prefix string) string {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// indentContinuation indents the lines after the first, so that multi-line
+// content stays inside its list item or table cell.
+func indentContinuation(text, prefix string) string {
+	first, rest, found := strings.Cut(text, "\n")
+	if !found {
+		return text
+	}
+
+	return first + "\n" + indentLines(rest, prefix)
+}
+
+func anchorID(anchor string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(anchor), " ", "-"))
+}
@@ -0,0 +1,78 @@
+package rst
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func parse(t *testing.T, blocks string) string {
+	out, err := Renderer{}.Parse(`{"blocks": [`+blocks+`]}`, support.Limits{})
+	assert.NoError(t, err)
+	return out
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\*b\_c \|x\| \`+"`"+`y\`+"`", Escape("a*b_c |x| `y`"))
+}
+
+func TestInline(t *testing.T) {
+	assert.Equal(t, "**bold**\\ word, *itx* and ``a_b``\\ x `a link <https://example.com/a%20b>`__.",
+		Inline(`<b>bold</b>word, <i>it<b>x</b></i> and <code class="inline-code">a_b</code>x <a href="https://example.com/a b">a link</a>.`))
+	assert.Equal(t, "**a**\\ *b*", Inline(`<b>a</b><i>b</i>`))
+	assert.Equal(t, "H\\ ``2``\\ O (``x``)", Inline(`H<code>2</code>O (<code>x</code>)`))
+}
+
+func TestHeader(t *testing.T) {
+	assert.Equal(t, "Title\n=====", parse(t, `{"type": "header", "data": {"level": 1, "text": "Title"}}`))
+	assert.Equal(t, ".. _intro:\n\nIntro *x*\n~~~~~~~~~", parse(t, `{"type": "header", "data": {"level": 3, "text": "Intro <i>x</i>", "anchor": "Intro"}}`))
+}
+
+func TestParagraph(t *testing.T) {
+	assert.Equal(t, `\- not a list`, parse(t, `{"type": "paragraph", "data": {"text": "- not a list"}}`))
+	assert.Equal(t, "| first\n| \\#. second", parse(t, `{"type": "paragraph", "data": {"text": "first<br>#. second"}}`))
+}
+
+func TestAdmonitions(t *testing.T) {
+	assert.Equal(t, ".. admonition:: Careful\n   :class: warning\n\n   Hot", parse(t, `{"type": "warning", "data": {"title": "Careful", "message": "Hot"}}`))
+	assert.Equal(t, ".. danger::\n\n   Bad", parse(t, `{"type": "alert", "data": {"type": "danger", "message": "Bad"}}`))
+}
+
+func TestNestedList(t *testing.T) {
+	expected := `#. one
+
+   #. two
+
+#. three`
+
+	assert.Equal(t, expected, parse(t, `{"type": "list", "data": {"style": "ordered", "items": [{"content": "one", "items": [{"content": "two", "items": []}]}, {"content": "three", "items": []}]}}`))
+	assert.Equal(t, "- a\n- b", parse(t, `{"type": "list", "data": {"style": "unordered", "items": ["a", "b"]}}`))
+}
+
+func TestTable(t *testing.T) {
+	expected := `.. list-table::
+   :header-rows: 1
+
+   * - A
+     - B\|C
+   * - 1
+     -`
+
+	assert.Equal(t, expected, parse(t, `{"type": "table", "data": {"withHeadings": true, "content": [["A", "B|C"], ["1"]]}}`))
+}
+
+func TestCode(t *testing.T) {
+	assert.Equal(t, ".. code-block:: python\n\n   print(1)\n\n   x = 2", parse(t, `{"type": "code", "data": {"code": "print(1)\n\nx = 2", "languageCode": "python"}}`))
+	assert.Equal(t, "::\n\n   a", parse(t, `{"type": "code", "data": {"code": "a"}}`))
+}
+
+func TestImage(t *testing.T) {
+	assert.Equal(t, ".. figure:: img/cat.png\n   :alt: A cat\n\n   A **cat**", parse(t, `{"type": "image", "data": {"file": {"url": "img/cat.png"}, "caption": "A <b>cat</b>"}}`))
+	assert.Equal(t, ".. image:: img/cat.png", parse(t, `{"type": "image", "data": {"url": "img/cat.png"}}`))
+}
+
+func TestLinkTool(t *testing.T) {
+	expected := ".. topic:: `Example <https://example.com>`__\n\n   .. image:: https://example.com/i.png\n\n   Description"
+
+	assert.Equal(t, expected, parse(t, `{"type": "linkTool", "data": {"link": "https://example.com", "meta": {"title": "Example", "description": "Description", "image": {"url": "https://example.com/i.png"}}}}`))
+}
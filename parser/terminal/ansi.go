@@ -0,0 +1,202 @@
+package terminal
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/inline"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var sgrPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// sgr wraps text in the SGR sequences on and off, or returns it unchanged
+// when colour is disabled.
+func (r Renderer) sgr(text, on, off string) string {
+	if !r.Color || text == "" {
+		return text
+	}
+	return "\x1b[" + on + "m" + text + "\x1b[" + off + "m"
+}
+
+func (r Renderer) bold(text string) string {
+	return r.sgr(text, "1", "22")
+}
+
+func (r Renderer) dim(text string) string {
+	return r.sgr(text, "2", "22")
+}
+
+func (r Renderer) underline(text string) string {
+	return r.sgr(text, "4", "24")
+}
+
+func (r Renderer) color(text, code string) string {
+	return r.sgr(text, code, "39")
+}
+
+// clean drops the C0 controls except newline and tab, DEL and the C1
+// controls from text taken from the document, so it can't carry its own
+// terminal escape sequences.
+func clean(text string) string {
+	return strings.Map(func(r rune) rune {
+		if (r < 0x20 && r != '\n' && r != '\t') || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, text)
+}
+
+// cleanURL is clean for single-line values such as URLs and names.
+func cleanURL(url string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, url)
+}
+
+type inlineRenderer struct {
+	r Renderer
+}
+
+// Inline converts the inline HTML of Editor.js text into text with ANSI
+// styles, or into plain text when colour is disabled.
+func (r Renderer) Inline(s string) string {
+	return inline.Convert(s, inlineRenderer{r: r})
+}
+
+func (ir inlineRenderer) Text(text string) string {
+	return clean(text)
+}
+
+func (ir inlineRenderer) Bold(inner string) string {
+	return ir.r.bold(inner)
+}
+
+func (ir inlineRenderer) Italic(inner string) string {
+	return ir.r.sgr(inner, "3", "23")
+}
+
+func (ir inlineRenderer) Underline(inner string) string {
+	return ir.r.underline(inner)
+}
+
+func (ir inlineRenderer) Strike(inner string) string {
+	return ir.r.sgr(inner, "9", "29")
+}
+
+func (ir inlineRenderer) Code(text string) string {
+	text = clean(text)
+	if !ir.r.Color {
+		return "`" + text + "`"
+	}
+	return ir.r.color(text, "36")
+}
+
+func (ir inlineRenderer) Mark(inner string) string {
+	return ir.r.sgr(inner, "7", "27")
+}
+
+func (ir inlineRenderer) Link(inner, href string) string {
+	href = cleanURL(href)
+	if href == "" || visible(inner) == href {
+		return ir.r.underline(inner)
+	}
+	return ir.r.underline(inner) + " " + ir.r.dim("<"+href+">")
+}
+
+func (ir inlineRenderer) Break() string {
+	return "\n"
+}
+
+// visible strips the ANSI sequences from text.
+func visible(text string) string {
+	return sgrPattern.ReplaceAllString(text, "")
+}
+
+func width(text string) int {
+	return utf8.RuneCountInString(visible(text))
+}
+
+// wrap word-wraps text to width columns, not counting ANSI sequences. Words
+// longer than a line are cut.
+func wrap(text string, columns int) []string {
+	if columns < 1 {
+		columns = 1
+	}
+
+	var lines []string
+
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+
+		for _, word := range strings.Fields(paragraph) {
+			if line != "" && lineWidth(line, word) > columns {
+				lines = append(lines, line)
+				line = ""
+			}
+
+			if line != "" {
+				line += " " + word
+				continue
+			}
+
+			for width(word) > columns {
+				var head string
+				head, word = cut(word, columns)
+				lines = append(lines, head)
+			}
+			line = word
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func lineWidth(line, word string) int {
+	if line == "" {
+		return width(word)
+	}
+	return width(line) + 1 + width(word)
+}
+
+// cut splits word after n visible characters, keeping ANSI sequences whole.
+func cut(word string, n int) (string, string) {
+	count := 0
+	for i := 0; i < len(word); {
+		if loc := sgrPattern.FindStringIndex(word[i:]); loc != nil && loc[0] == 0 {
+			i += loc[1]
+			continue
+		}
+		if count == n {
+			return word[:i], word[i:]
+		}
+		_, size := utf8.DecodeRuneInString(word[i:])
+		i += size
+		count++
+	}
+	return word, ""
+}
+
+// pad fills text with spaces to width columns.
+func pad(text string, columns int) string {
+	if w := width(text); w < columns {
+		return text + strings.Repeat(" ", columns-w)
+	}
+	return text
+}
+
+// indent prefixes the first line with first and the others with rest.
+func indent(lines []string, first, rest string) string {
+	for i := range lines {
+		if i == 0 {
+			lines[i] = first + lines[i]
+		} else {
+			lines[i] = rest + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
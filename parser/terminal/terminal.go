@@ -0,0 +1,360 @@
+package terminal
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const DefaultWidth = 80
+
+type Renderer struct {
+	// Width is the number of columns text is wrapped to.
+	Width int
+	// Color enables ANSI styles; without it the output is plain text.
+	Color bool
+}
+
+// alertColors maps the alert types to SGR foreground colours.
+var alertColors = map[string]string{
+	"primary":   "34",
+	"secondary": "90",
+	"info":      "36",
+	"success":   "32",
+	"warning":   "33",
+	"danger":    "31",
+	"light":     "37",
+	"dark":      "90",
+}
+
+// New returns a renderer for the given width, with colour unless the
+// NO_COLOR environment variable is set.
+func New(width int) Renderer {
+	return Renderer{Width: width, Color: os.Getenv("NO_COLOR") == ""}
+}
+
+func (r Renderer) width() int {
+	if r.Width <= 0 {
+		return DefaultWidth
+	}
+	return r.Width
+}
+
+func (r Renderer) Header(el *domain.EditorJSDataHeader) string {
+	lines := wrap(r.Inline(el.Text), r.width())
+
+	title := r.bold(strings.Join(lines, "\n"))
+
+	rule := 0
+	for _, line := range lines {
+		if w := width(line); w > rule {
+			rule = w
+		}
+	}
+
+	switch el.Level {
+	case 1:
+		return title + "\n" + strings.Repeat("═", rule)
+	case 2:
+		return title + "\n" + strings.Repeat("─", rule)
+	}
+
+	return title
+}
+
+func (r Renderer) Paragraph(el *domain.EditorJSDataParagraph) string {
+	lines := wrap(r.Inline(el.Text), r.width())
+
+	for i, line := range lines {
+		switch el.Alignment {
+		case "center":
+			lines[i] = strings.Repeat(" ", (r.width()-width(line))/2) + line
+		case "right":
+			lines[i] = strings.Repeat(" ", r.width()-width(line)) + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (r Renderer) Quote(el *domain.EditorJSDataQuote) string {
+	bar := r.dim("│") + " "
+
+	quote := indent(wrap(r.Inline(el.Text), r.width()-2), bar, bar)
+
+	if el.Caption != "" {
+		quote += "\n" + indent(wrap("— "+r.Inline(el.Caption), r.width()-2), bar, bar)
+	}
+
+	return quote
+}
+
+func (r Renderer) Warning(el *domain.EditorJSDataWarning) string {
+	return r.callout("33", el.Title, el.Message, "⚠")
+}
+
+func (r Renderer) Delimiter() string {
+	return r.dim(strings.Repeat("─", r.width()))
+}
+
+// Alert colours the bar by the alert type; without colour the type is
+// written as a label so it isn't lost.
+func (r Renderer) Alert(el *domain.EditorJSDataAlert) string {
+	code, ok := alertColors[el.Type]
+	if !ok {
+		code = alertColors["info"]
+	}
+
+	title := ""
+	if !r.Color && el.Type != "" {
+		title = strings.ToUpper(el.Type[:1]) + el.Type[1:]
+	}
+
+	return r.callout(code, title, el.Message, "")
+}
+
+func (r Renderer) callout(code, title, message, icon string) string {
+	bar := r.color("▌", code) + " "
+
+	var lines []string
+
+	if title != "" || icon != "" {
+		heading := strings.TrimSpace(icon + " " + r.Inline(title))
+		lines = append(lines, r.bold(r.color(heading, code)))
+	}
+
+	lines = append(lines, wrap(r.Inline(message), r.width()-2)...)
+
+	return indent(lines, bar, bar)
+}
+
+func (r Renderer) List(el *domain.EditorJSDataList) (string, error) {
+	itemsList, err := support.ListItems(el.Items)
+	if err != nil {
+		return "", err
+	}
+
+	return r.nestedList(itemsList, el.Style == "unordered", 0), nil
+}
+
+var bullets = []string{"•", "◦", "▪"}
+
+func (r Renderer) nestedList(items []domain.NestedListItem, unordered bool, depth int) string {
+	var result []string
+
+	margin := strings.Repeat("  ", depth)
+
+	for i, item := range items {
+		marker := bullets[depth%len(bullets)] + " "
+		if !unordered {
+			marker = strconv.Itoa(i+1) + ". "
+		}
+
+		hanging := margin + strings.Repeat(" ", width(marker))
+		result = append(result, indent(wrap(r.Inline(item.Content), r.width()-len(hanging)), margin+marker, hanging))
+
+		if len(item.Items) > 0 {
+			result = append(result, r.nestedList(item.Items, unordered, depth+1))
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Checklist(el *domain.EditorJSDataChecklist) string {
+	var result []string
+
+	for _, item := range el.Items {
+		box := "☐"
+		if item.Checked {
+			box = r.color("☑", "32")
+		}
+
+		result = append(result, indent(wrap(r.Inline(item.Text), r.width()-2), box+" ", "  "))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// Table box-draws the table, narrowing the widest columns and wrapping their
+// cells when it doesn't fit the width.
+func (r Renderer) Table(el *domain.EditorJSDataTable) string {
+	columns := 0
+	for _, row := range el.Content {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	if columns == 0 {
+		return ""
+	}
+
+	cells := make([][]string, len(el.Content))
+	widths := make([]int, columns)
+
+	for i, row := range el.Content {
+		cells[i] = make([]string, columns)
+		for j, cell := range row {
+			cells[i][j] = r.Inline(cell)
+			for _, line := range strings.Split(cells[i][j], "\n") {
+				if w := width(line); w > widths[j] {
+					widths[j] = w
+				}
+			}
+		}
+	}
+
+	// Each column takes its content plus a space either side and a border.
+	for available := r.width() - 1 - 3*columns; ; {
+		total, widest := 0, 0
+		for j, w := range widths {
+			total += w
+			if w > widths[widest] {
+				widest = j
+			}
+		}
+		if total <= available || widths[widest] <= 3 {
+			break
+		}
+		widths[widest]--
+	}
+
+	rule := func(left, middle, right string) string {
+		parts := make([]string, columns)
+		for j, w := range widths {
+			parts[j] = strings.Repeat("─", w+2)
+		}
+		return left + strings.Join(parts, middle) + right
+	}
+
+	result := []string{rule("┌", "┬", "┐")}
+
+	for i, row := range cells {
+		if i == 1 && el.WithHeadings {
+			result = append(result, rule("├", "┼", "┤"))
+		}
+
+		wrapped := make([][]string, columns)
+		height := 0
+		for j, cell := range row {
+			wrapped[j] = wrap(cell, widths[j])
+			if len(wrapped[j]) > height {
+				height = len(wrapped[j])
+			}
+		}
+
+		for line := 0; line < height; line++ {
+			parts := make([]string, columns)
+			for j := range row {
+				text := ""
+				if line < len(wrapped[j]) {
+					text = wrapped[j][line]
+				}
+				// Headings are bolded per line so the style ends before the border.
+				if i == 0 && el.WithHeadings {
+					text = r.bold(text)
+				}
+				parts[j] = " " + pad(text, widths[j]) + " "
+			}
+			result = append(result, "│"+strings.Join(parts, "│")+"│")
+		}
+	}
+
+	result = append(result, rule("└", "┴", "┘"))
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) AnyButton(el *domain.EditorJSDataAnyButton) string {
+	return r.bold("[ "+r.Inline(el.Text)+" ]") + " " + r.underline(cleanURL(el.Link))
+}
+
+func (r Renderer) Code(el *domain.EditorJSDataCode) string {
+	return r.code(el.Code, el.LanguageCode)
+}
+
+func (r Renderer) Raw(el *domain.EditorJSDataRaw) string {
+	return r.code(el.Html, "html")
+}
+
+// code indents the lines without wrapping them, so that code stays intact.
+func (r Renderer) code(code, language string) string {
+	var result []string
+
+	if language != "" {
+		result = append(result, r.dim(cleanURL(language)))
+	}
+
+	for _, line := range strings.Split(clean(code), "\n") {
+		result = append(result, "    "+r.color(line, "36"))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Image(el *domain.EditorJSDataImage) string {
+	url := el.URL
+	if el.File.URL != "" {
+		url = el.File.URL
+	}
+
+	caption := r.Inline(el.Caption)
+	if caption == "" {
+		caption = "Image"
+	}
+
+	return "[" + caption + "] " + r.underline(cleanURL(url))
+}
+
+func (r Renderer) LinkTool(el *domain.EditorJSDataLinkTool) string {
+	link := cleanURL(el.Link)
+
+	title := r.Inline(el.Meta.Title)
+	if title == "" {
+		title = link
+	}
+
+	var result []string
+
+	result = append(result, wrap(r.bold(title), r.width())...)
+
+	if el.Meta.Description != "" {
+		result = append(result, wrap(r.Inline(el.Meta.Description), r.width())...)
+	}
+
+	result = append(result, r.underline(link))
+
+	return strings.Join(result, "\n")
+}
+
+func (r Renderer) Attaches(el *domain.EditorJSDataAttaches) string {
+	name := el.File.Name
+	if name == "" {
+		name = el.Title
+	}
+
+	return cleanURL(name) + " " + r.dim("("+support.HumanFileSize(el.File.Size)+")") + " " + r.underline(cleanURL(el.File.URL))
+}
+
+func (r Renderer) Embed(el *domain.EditorJSDataEmbed) string {
+	link := "Watch on " + cleanURL(el.Service) + ": " + r.underline(cleanURL(el.Source))
+
+	if el.Caption != "" {
+		return strings.Join(wrap(r.Inline(el.Caption), r.width()), "\n") + "\n" + link
+	}
+
+	return link
+}
+
+func (r Renderer) ImageGallery(el *domain.EditorJSDataImageGallery) string {
+	var result []string
+
+	for index, url := range el.URLs {
+		result = append(result, "[Image "+strconv.Itoa(index+1)+"] "+r.underline(cleanURL(url)))
+	}
+
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,97 @@
+package terminal
+
+import (
+	"github.com/banjuanshu/go-editorjs/support"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func parse(t *testing.T, r Renderer, blocks string) string {
+	out, err := r.Parse(`{"blocks": [`+blocks+`]}`, support.Limits{})
+	assert.NoError(t, err)
+	return out
+}
+
+func TestParagraphWrap(t *testing.T) {
+	out := parse(t, Renderer{Width: 12}, `{"type": "paragraph", "data": {"text": "The quick brown fox jumps over <b>the lazy</b> dog"}}`)
+
+	assert.Equal(t, "The quick\nbrown fox\njumps over\nthe lazy dog", out)
+}
+
+func TestInlineStyles(t *testing.T) {
+	out := parse(t, Renderer{Width: 80, Color: true}, `{"type": "paragraph", "data": {"text": "<b>bold</b> <i>italic</i> <u class=\"cdx-underline\">under</u> <a href=\"https://example.com\">link</a>"}}`)
+
+	assert.Equal(t, "\x1b[1mbold\x1b[22m \x1b[3mitalic\x1b[23m \x1b[4munder\x1b[24m \x1b[4mlink\x1b[24m \x1b[2m<https://example.com>\x1b[22m", out)
+}
+
+func TestNoColor(t *testing.T) {
+	blocks := `{"type": "header", "data": {"level": 1, "text": "Title"}},
+		{"type": "paragraph", "data": {"text": "<b>bold</b> and <code class=\"inline-code\">code</code>"}},
+		{"type": "alert", "data": {"type": "danger", "message": "Careful"}},
+		{"type": "checklist", "data": {"items": [{"text": "done", "checked": true}]}}`
+
+	out := parse(t, Renderer{Width: 80}, blocks)
+
+	assert.NotContains(t, out, "\x1b")
+	assert.Equal(t, "Title\n═════\n\nbold and `code`\n\n▌ Danger\n▌ Careful\n\n☑ done", out)
+}
+
+func TestAlertColor(t *testing.T) {
+	out := parse(t, Renderer{Width: 80, Color: true}, `{"type": "alert", "data": {"type": "success", "message": "Saved"}}`)
+
+	assert.Equal(t, "\x1b[32m▌\x1b[39m Saved", out)
+}
+
+func TestChecklist(t *testing.T) {
+	out := parse(t, Renderer{Width: 12}, `{"type": "checklist", "data": {"items": [{"text": "Write the tests", "checked": true}, {"text": "Ship", "checked": false}]}}`)
+
+	assert.Equal(t, "☑ Write the\n  tests\n☐ Ship", out)
+}
+
+func TestTable(t *testing.T) {
+	expected := `┌──────┬───────┐
+│ Name │ Value │
+├──────┼───────┤
+│ a    │ 1     │
+└──────┴───────┘`
+
+	assert.Equal(t, expected, parse(t, Renderer{Width: 80}, `{"type": "table", "data": {"withHeadings": true, "content": [["Name", "Value"], ["a", "1"]]}}`))
+}
+
+func TestTableShrinks(t *testing.T) {
+	out := parse(t, Renderer{Width: 20}, `{"type": "table", "data": {"content": [["short", "a much longer cell text"]]}}`)
+
+	for _, line := range strings.Split(out, "\n") {
+		assert.LessOrEqual(t, width(line), 20)
+	}
+	assert.Contains(t, out, "│ short │ a much   │")
+}
+
+func TestNestedList(t *testing.T) {
+	items := `[{"content": "one", "items": [{"content": "two", "items": []}]}, {"content": "three", "items": []}]`
+
+	assert.Equal(t, "• one\n  ◦ two\n• three", parse(t, Renderer{Width: 80}, `{"type": "list", "data": {"style": "unordered", "items": `+items+`}}`))
+	assert.Equal(t, "1. one\n  1. two\n2. three", parse(t, Renderer{Width: 80}, `{"type": "list", "data": {"style": "ordered", "items": `+items+`}}`))
+}
+
+func TestControlCharactersAreStripped(t *testing.T) {
+	blocks := `{"type": "paragraph", "data": {"text": "<a href=\"x&#27;]2;pwned&#7;\">title</a> and \u009b31m text&#127;"}},
+		{"type": "AnyButton", "data": {"link": "\u001b[31mRED", "text": "Go\u0007"}},
+		{"type": "image", "data": {"file": {"url": "https://example.com/\u009b2J.png"}, "caption": "Cat"}},
+		{"type": "code", "data": {"code": "a\tb\n\u001b]0;title\u0007c", "languageCode": "go\u001b[0m"}},
+		{"type": "embed", "data": {"service": "you\u001btube", "source": "https://youtu.be/x\r\n"}}`
+
+	for _, color := range []bool{false, true} {
+		out := parse(t, Renderer{Width: 80, Color: color}, blocks)
+
+		for _, r := range visible(out) {
+			assert.False(t, (r < 0x20 && r != '\n' && r != '\t') || (r >= 0x7f && r <= 0x9f), "control character %U in output", r)
+		}
+	}
+
+	out := parse(t, Renderer{Width: 80}, blocks)
+	assert.Contains(t, out, "title <x]2;pwned> and 31m text")
+	assert.Contains(t, out, "[ Go ] [31mRED")
+	assert.Contains(t, out, "    a\tb\n    ]0;titlec")
+}
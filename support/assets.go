@@ -0,0 +1,84 @@
+package support
+
+import (
+	"errors"
+	"github.com/banjuanshu/go-editorjs/support/config"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+)
+
+var (
+	assetFSMu sync.RWMutex
+	assetFS   fs.FS
+)
+
+// SetAssetFS layers fsys over the embedded assets. Files are looked up with
+// the same layout as the embedded ones (assets/css/…, assets/js/…,
+// assets/json/…, libs/<block>/<block>.css), so a file in fsys overrides the
+// embedded one and new block libraries can be added. A nil fsys removes the
+// overlay.
+func SetAssetFS(fsys fs.FS) {
+	assetFSMu.Lock()
+	defer assetFSMu.Unlock()
+
+	assetFS = fsys
+
+	resetMinified()
+}
+
+func ReadAssetFile(name string) ([]byte, error) {
+	assetFSMu.RLock()
+	overlay := assetFS
+	assetFSMu.RUnlock()
+
+	if overlay != nil {
+		content, err := fs.ReadFile(overlay, name)
+		if err == nil {
+			return content, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return fs.ReadFile(embeddedFS{}, name)
+}
+
+func HasLib(name string) bool {
+	assetFSMu.RLock()
+	overlay := assetFS
+	assetFSMu.RUnlock()
+
+	if overlay != nil {
+		if _, err := fs.Stat(overlay, config.LibsPath+name); err == nil {
+			return true
+		}
+	}
+
+	_, err := fs.Stat(embeddedFS{}, config.LibsPath+name)
+	return err == nil
+}
+
+// LocalPath cleans a path taken from a document into a name for an fs.FS.
+// Absolute paths and paths that leave the root through ".." are rejected.
+func LocalPath(name string) (string, error) {
+	cleaned := path.Clean(name)
+
+	if path.IsAbs(cleaned) || !fs.ValidPath(cleaned) {
+		return "", &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	return cleaned, nil
+}
+
+// embeddedFS joins the embedded assets and libs directories into one tree.
+type embeddedFS struct{}
+
+func (embeddedFS) Open(name string) (fs.File, error) {
+	if name == "libs" || strings.HasPrefix(name, config.LibsPath) {
+		return libsFiles.Open(name)
+	}
+	return assetsFiles.Open(name)
+}
@@ -0,0 +1,9 @@
+document.addEventListener('DOMContentLoaded', () => {
+    (document.querySelectorAll('[data-dismiss="alert"]') || []).forEach(($close) => {
+        const $alert = $close.parentNode;
+
+        $close.addEventListener('click', () => {
+            $alert.parentNode.removeChild($alert);
+        });
+    });
+});
@@ -0,0 +1,34 @@
+package support
+
+import (
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestAssetOverlay(t *testing.T) {
+	is := is.New(t)
+
+	is.True(HasLib("imagegallery")) // embedded libs are found without the repo on disk
+	is.True(!HasLib("paragraph"))   // blocks without a lib
+	is.True(len(MinifyLib("imagegallery/imagegallery.css", "css")) > 0)
+
+	SetAssetFS(fstest.MapFS{
+		"libs/paragraph/paragraph.css":       &fstest.MapFile{Data: []byte("p { margin: 0 }")},
+		"libs/imagegallery/imagegallery.css": &fstest.MapFile{Data: []byte(".gallery { display: grid }")},
+	})
+	defer SetAssetFS(nil)
+
+	is.True(HasLib("paragraph")) // overlay adds libs
+	is.Equal(string(MinifyLib("paragraph/paragraph.css", "css")), "p{margin:0}")
+	is.Equal(string(MinifyLib("imagegallery/imagegallery.css", "css")), ".gallery{display:grid}")  // overlay overrides embedded files
+	is.True(strings.Contains(string(MinifyLib("imagegallery/imagegallery.js", "js")), "function")) // embedded files are still used
+
+	styleMap, err := StyleMapAsset("bootstrap.json")
+	is.NoErr(err)
+	is.Equal(styleMap.StyleName, "bootstrap")
+
+	_, err = StyleMapAsset("missing.json")
+	is.True(err != nil) // missing maps are reported, not fatal
+}
@@ -0,0 +1,132 @@
+package support
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+)
+
+// BlockRenderer has a method for every block type. Renderers that write the
+// blocks into a document they hold return empty strings.
+type BlockRenderer interface {
+	Header(el *domain.EditorJSDataHeader) string
+	Paragraph(el *domain.EditorJSDataParagraph) string
+	Quote(el *domain.EditorJSDataQuote) string
+	Warning(el *domain.EditorJSDataWarning) string
+	Delimiter() string
+	Alert(el *domain.EditorJSDataAlert) string
+	List(el *domain.EditorJSDataList) (string, error)
+	Checklist(el *domain.EditorJSDataChecklist) string
+	Table(el *domain.EditorJSDataTable) string
+	AnyButton(el *domain.EditorJSDataAnyButton) string
+	Code(el *domain.EditorJSDataCode) string
+	Raw(el *domain.EditorJSDataRaw) string
+	Image(el *domain.EditorJSDataImage) string
+	LinkTool(el *domain.EditorJSDataLinkTool) string
+	Attaches(el *domain.EditorJSDataAttaches) string
+	Embed(el *domain.EditorJSDataEmbed) string
+	ImageGallery(el *domain.EditorJSDataImageGallery) string
+}
+
+// RenderBlocks parses the document within limits and renders each block with
+// r, in order. Blocks of unknown types are skipped.
+func RenderBlocks(jsonstr string, limits Limits, r BlockRenderer) ([]string, error) {
+	editorJSAST, err := ParseEditorJSONWithLimits(jsonstr, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	return RenderEditorJSBlocks(editorJSAST.Blocks, limits, r)
+}
+
+// RenderEditorJSBlocks renders already parsed blocks, checking each against
+// limits first.
+func RenderEditorJSBlocks(blocks []domain.EditorJSBlock, limits Limits, r BlockRenderer) ([]string, error) {
+	var result []string
+
+	for _, el := range blocks {
+
+		if err := CheckBlockLimits(el, limits); err != nil {
+			return nil, err
+		}
+
+		content, ok, err := RenderBlock(el.Type, PrepareData(el), r)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			result = append(result, content)
+		}
+	}
+
+	return result, nil
+}
+
+// RenderBlock calls the method of r for the block type with the data from
+// PrepareData. ok is false for unknown block types.
+func RenderBlock(blockType string, data interface{}, r BlockRenderer) (content string, ok bool, err error) {
+	ok = true
+
+	switch blockType {
+
+	case "header":
+		content = r.Header(data.(*domain.EditorJSDataHeader))
+	case "paragraph":
+		content = r.Paragraph(data.(*domain.EditorJSDataParagraph))
+	case "quote":
+		content = r.Quote(data.(*domain.EditorJSDataQuote))
+	case "warning":
+		content = r.Warning(data.(*domain.EditorJSDataWarning))
+	case "delimiter":
+		content = r.Delimiter()
+	case "alert":
+		content = r.Alert(data.(*domain.EditorJSDataAlert))
+	case "list":
+		content, err = r.List(data.(*domain.EditorJSDataList))
+	case "checklist":
+		content = r.Checklist(data.(*domain.EditorJSDataChecklist))
+	case "table":
+		content = r.Table(data.(*domain.EditorJSDataTable))
+	case "AnyButton":
+		content = r.AnyButton(data.(*domain.EditorJSDataAnyButton))
+	case "code":
+		content = r.Code(data.(*domain.EditorJSDataCode))
+	case "raw":
+		content = r.Raw(data.(*domain.EditorJSDataRaw))
+	case "image":
+		content = r.Image(data.(*domain.EditorJSDataImage))
+	case "linkTool":
+		content = r.LinkTool(data.(*domain.EditorJSDataLinkTool))
+	case "attaches":
+		content = r.Attaches(data.(*domain.EditorJSDataAttaches))
+	case "embed":
+		content = r.Embed(data.(*domain.EditorJSDataEmbed))
+	case "imageGallery":
+		content = r.ImageGallery(data.(*domain.EditorJSDataImageGallery))
+	default:
+		ok = false
+	}
+
+	return
+}
+
+// ListItems decodes the items of a list block. Nested lists keep their
+// structure; the items of old flat lists become items without children.
+func ListItems(items []interface{}) ([]domain.NestedListItem, error) {
+	content, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	var itemsList []domain.NestedListItem
+
+	if err = json.Unmarshal(content, &itemsList); err != nil {
+		itemsList = nil
+		for _, item := range items {
+			itemsList = append(itemsList, domain.NestedListItem{Content: fmt.Sprintf("%v", item)})
+		}
+	}
+
+	return itemsList, nil
+}
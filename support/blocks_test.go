@@ -0,0 +1,91 @@
+package support
+
+import (
+	"errors"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/matryer/is"
+	"math"
+	"testing"
+)
+
+var errList = errors.New("list failed")
+
+// typeRenderer renders every block as its type, and fails on lists styled
+// "fail".
+type typeRenderer struct{}
+
+func (typeRenderer) Header(el *domain.EditorJSDataHeader) string       { return "header " + el.Text }
+func (typeRenderer) Paragraph(el *domain.EditorJSDataParagraph) string { return "paragraph " + el.Text }
+func (typeRenderer) Quote(*domain.EditorJSDataQuote) string            { return "quote" }
+func (typeRenderer) Warning(*domain.EditorJSDataWarning) string        { return "warning" }
+func (typeRenderer) Delimiter() string                                 { return "delimiter" }
+func (typeRenderer) Alert(*domain.EditorJSDataAlert) string            { return "alert" }
+func (typeRenderer) Checklist(*domain.EditorJSDataChecklist) string    { return "checklist" }
+func (typeRenderer) Table(*domain.EditorJSDataTable) string            { return "table" }
+func (typeRenderer) AnyButton(*domain.EditorJSDataAnyButton) string    { return "AnyButton" }
+func (typeRenderer) Code(*domain.EditorJSDataCode) string              { return "code" }
+func (typeRenderer) Raw(*domain.EditorJSDataRaw) string                { return "raw" }
+func (typeRenderer) Image(*domain.EditorJSDataImage) string            { return "image" }
+func (typeRenderer) LinkTool(*domain.EditorJSDataLinkTool) string      { return "linkTool" }
+func (typeRenderer) Attaches(*domain.EditorJSDataAttaches) string      { return "attaches" }
+func (typeRenderer) Embed(*domain.EditorJSDataEmbed) string            { return "embed" }
+
+func (typeRenderer) ImageGallery(*domain.EditorJSDataImageGallery) string { return "imageGallery" }
+
+func (typeRenderer) List(el *domain.EditorJSDataList) (string, error) {
+	if el.Style == "fail" {
+		return "", errList
+	}
+	return "list", nil
+}
+
+func TestRenderBlocks(t *testing.T) {
+	is := is.New(t)
+
+	input := `{"blocks": [
+		{"type": "header", "data": {"level": 1, "text": "Title"}},
+		{"type": "unknown", "data": {}},
+		{"type": "paragraph", "data": {"text": "Text"}},
+		{"type": "list", "data": {"style": "ordered", "items": ["a"]}},
+		{"type": "delimiter", "data": {}}
+	]}`
+
+	result, err := RenderBlocks(input, Limits{}, typeRenderer{})
+	is.NoErr(err)
+	is.Equal(result, []string{"header Title", "paragraph Text", "list", "delimiter"}) // unknown blocks are skipped
+
+	_, err = RenderBlocks(`{"blocks": [{"type": "list", "data": {"style": "fail", "items": []}}]}`, Limits{}, typeRenderer{})
+	is.True(errors.Is(err, errList)) // list errors are returned
+
+	_, err = RenderBlocks(input, Limits{MaxBlocks: 2}, typeRenderer{})
+	is.True(errors.Is(err, ErrLimitExceeded)) // limits are applied
+
+	_, ok, err := RenderBlock("unknown", nil, typeRenderer{})
+	is.NoErr(err)
+	is.True(!ok) // unknown block types are reported
+}
+
+func TestListItems(t *testing.T) {
+	is := is.New(t)
+
+	items, err := ListItems([]interface{}{
+		map[string]interface{}{"content": "one", "items": []interface{}{
+			map[string]interface{}{"content": "two", "items": []interface{}{}},
+		}},
+	})
+	is.NoErr(err)
+	is.Equal(len(items), 1)
+	is.Equal(items[0].Content, "one")
+	is.Equal(items[0].Items[0].Content, "two") // nested items keep their structure
+
+	items, err = ListItems([]interface{}{"a", 2.5})
+	is.NoErr(err)
+	is.Equal(items, []domain.NestedListItem{{Content: "a"}, {Content: "2.5"}}) // flat items have no children
+
+	_, err = ListItems([]interface{}{math.NaN()})
+	is.True(err != nil) // values JSON cannot hold are reported
+
+	items, err = ListItems(nil)
+	is.NoErr(err)
+	is.Equal(len(items), 0)
+}
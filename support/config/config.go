@@ -1,12 +1,28 @@
 package config
 
 const (
-	LibsPath         = "support/libs/"
+	LibsPath         = "libs/"
 	AssetsStylePath  = "assets/css/"
 	AssetsScriptPath = "assets/js/"
 	AssetsMapPath    = "assets/json/"
+	AssetsImagePath  = "assets/images/"
 )
 
+const (
+	FileIconURL     = "https://i.ibb.co/K7Myr2k/file-icon.png"
+	DownloadIconURL = "https://i.ibb.co/VYyHr6C/download-icon.png"
+)
+
+// StyleLister lists the registered styles; the support package sets it to
+// support.Styles.
+var StyleLister func() []string
+
+// AvailableStyles returns the names of the registered styles.
+//
+// Deprecated: use support.Styles.
 func AvailableStyles() []string {
-	return []string{"sample", "bootstrap", "bulma"}
+	if StyleLister == nil {
+		return nil
+	}
+	return StyleLister()
 }
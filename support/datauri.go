@@ -0,0 +1,18 @@
+package support
+
+import (
+	"encoding/base64"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+)
+
+func DataURI(name string, content []byte) string {
+	mediaType := mime.TypeByExtension(strings.ToLower(path.Ext(name)))
+	if mediaType == "" {
+		mediaType = http.DetectContentType(content)
+	}
+
+	return "data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(content)
+}
@@ -1,12 +1,15 @@
 package domain
 
 type EditorJS struct {
-	Blocks []EditorJSBlock `json:"blocks"`
+	Time    int64           `json:"time,omitempty"`
+	Blocks  []EditorJSBlock `json:"blocks"`
+	Version string          `json:"version,omitempty"`
 }
 
 type EditorJSBlock struct {
-	Type string      `json:"type"`
-	Data interface{} `json:"data"`
+	Type  string                 `json:"type"`
+	Data  interface{}            `json:"data"`
+	Tunes map[string]interface{} `json:"tunes,omitempty"`
 }
 
 type EditorJSDataHeader struct {
@@ -15,7 +15,7 @@ type EditorJSMethods interface {
 	Warning()
 	Delimiter()
 	Alert()
-	List()
+	List() error
 	Checklist()
 	Table()
 	AnyButton()
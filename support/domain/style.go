@@ -1,12 +1,17 @@
 package domain
 
 type StyleMap struct {
+	Extends            string            `json:"extends,omitempty"`
 	StyleName          string            `json:"styleName"`
 	LibraryPaths       []string          `json:"libraryPaths"`
+	LocalLibraryPaths  []string          `json:"localLibraryPaths,omitempty"`
 	PageHead           []string          `json:"pageHead"`
 	SpaceBetweenBlocks string            `json:"spaceBetweenBlocks"`
+	Container          string            `json:"container,omitempty"`
+	Elements           map[string]string `json:"elements,omitempty"`
 	Alignment          map[string]string `json:"alignment"`
 	Blocks             Blocks            `json:"blocks"`
+	Templates          map[string]string `json:"templates,omitempty"`
 }
 
 type Blocks struct {
@@ -38,12 +43,12 @@ type QuoteStyle struct {
 type WarningStyle struct {
 	Block       string `json:"block"`
 	Title       string `json:"title"`
-	CloseButton bool   `json:"closeButton"`
+	CloseButton bool   `json:"closeButton,omitempty"`
 }
 
 type AlertStyle struct {
 	Block       string            `json:"block"`
-	CloseButton bool              `json:"closeButton"`
+	CloseButton bool              `json:"closeButton,omitempty"`
 	Types       map[string]string `json:"types"`
 }
 
@@ -79,11 +84,12 @@ type ImageStyle struct {
 	Border     string `json:"border"`
 	Stretched  string `json:"stretched"`
 	Background string `json:"background"`
+	Caption    string `json:"caption,omitempty"`
 }
 
 type LinkToolStyle struct {
 	Link            string `json:"link"`
-	Container       string `json:"container"`
+	Container       string `json:"container,omitempty"`
 	Row             string `json:"row"`
 	LeftColumn      string `json:"leftColumn"`
 	RightColumn     string `json:"rightColumn"`
@@ -95,7 +101,7 @@ type LinkToolStyle struct {
 
 type AttachesStyle struct {
 	Link         string `json:"link"`
-	Container    string `json:"container"`
+	Container    string `json:"container,omitempty"`
 	Row          string `json:"row"`
 	LeftColumn   string `json:"leftColumn"`
 	CenterColumn string `json:"centerColumn"`
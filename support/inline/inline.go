@@ -0,0 +1,177 @@
+package inline
+
+import (
+	"golang.org/x/net/html"
+	"strings"
+)
+
+type Kind int
+
+const (
+	Text Kind = iota
+	Bold
+	Italic
+	Underline
+	Strike
+	Code
+	Mark
+	Link
+	Break
+)
+
+type Node struct {
+	Kind     Kind
+	Text     string
+	Href     string
+	Children []Node
+}
+
+type Renderer interface {
+	Text(text string) string
+	Bold(inner string) string
+	Italic(inner string) string
+	Underline(inner string) string
+	Strike(inner string) string
+	Code(text string) string
+	Mark(inner string) string
+	Link(inner, href string) string
+	Break() string
+}
+
+var tagKinds = map[string]Kind{
+	"b":      Bold,
+	"strong": Bold,
+	"i":      Italic,
+	"em":     Italic,
+	"u":      Underline,
+	"s":      Strike,
+	"strike": Strike,
+	"del":    Strike,
+	"code":   Code,
+	"mark":   Mark,
+	"a":      Link,
+}
+
+// Parse turns the inline HTML produced by Editor.js inline tools into a tree
+// of nodes. Unknown tags are dropped while their content is kept.
+func Parse(s string) []Node {
+	root := &Node{}
+	stack := []*Node{root}
+	tags := []string{""}
+
+	z := html.NewTokenizer(strings.NewReader(s))
+
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+
+		token := z.Token()
+		current := stack[len(stack)-1]
+
+		switch tt {
+		case html.TextToken:
+			current.Children = append(current.Children, Node{Kind: Text, Text: token.Data})
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if token.Data == "br" {
+				current.Children = append(current.Children, Node{Kind: Break})
+				continue
+			}
+
+			kind, ok := tagKinds[token.Data]
+			if !ok || tt == html.SelfClosingTagToken {
+				continue
+			}
+
+			node := Node{Kind: kind}
+			if kind == Link {
+				node.Href = attribute(token, "href")
+			}
+
+			current.Children = append(current.Children, node)
+			stack = append(stack, &current.Children[len(current.Children)-1])
+			tags = append(tags, token.Data)
+
+		case html.EndTagToken:
+			for i := len(tags) - 1; i > 0; i-- {
+				if tags[i] == token.Data {
+					stack = stack[:i]
+					tags = tags[:i]
+					break
+				}
+			}
+		}
+	}
+
+	return root.Children
+}
+
+func attribute(token html.Token, name string) string {
+	for _, attr := range token.Attr {
+		if attr.Key == name {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func PlainText(nodes []Node) string {
+	var result strings.Builder
+
+	for _, node := range nodes {
+		switch node.Kind {
+		case Text:
+			result.WriteString(node.Text)
+		case Break:
+			result.WriteString("\n")
+		default:
+			result.WriteString(PlainText(node.Children))
+		}
+	}
+
+	return result.String()
+}
+
+func Render(nodes []Node, r Renderer) string {
+	var result strings.Builder
+
+	for _, node := range nodes {
+		switch node.Kind {
+		case Text:
+			result.WriteString(r.Text(node.Text))
+		case Break:
+			result.WriteString(r.Break())
+		case Code:
+			result.WriteString(r.Code(PlainText(node.Children)))
+		default:
+			inner := Render(node.Children, r)
+			if strings.TrimSpace(inner) == "" {
+				result.WriteString(inner)
+				continue
+			}
+
+			switch node.Kind {
+			case Bold:
+				result.WriteString(r.Bold(inner))
+			case Italic:
+				result.WriteString(r.Italic(inner))
+			case Underline:
+				result.WriteString(r.Underline(inner))
+			case Strike:
+				result.WriteString(r.Strike(inner))
+			case Mark:
+				result.WriteString(r.Mark(inner))
+			case Link:
+				result.WriteString(r.Link(inner, node.Href))
+			}
+		}
+	}
+
+	return result.String()
+}
+
+func Convert(s string, r Renderer) string {
+	return Render(Parse(s), r)
+}
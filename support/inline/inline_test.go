@@ -0,0 +1,67 @@
+package inline
+
+import (
+	"github.com/matryer/is"
+	"testing"
+)
+
+// tagRenderer writes every node kind in a form that shows the tree.
+type tagRenderer struct{}
+
+func (tagRenderer) Text(text string) string        { return text }
+func (tagRenderer) Bold(inner string) string       { return "[b:" + inner + "]" }
+func (tagRenderer) Italic(inner string) string     { return "[i:" + inner + "]" }
+func (tagRenderer) Underline(inner string) string  { return "[u:" + inner + "]" }
+func (tagRenderer) Strike(inner string) string     { return "[s:" + inner + "]" }
+func (tagRenderer) Code(text string) string        { return "[code:" + text + "]" }
+func (tagRenderer) Mark(inner string) string       { return "[mark:" + inner + "]" }
+func (tagRenderer) Link(inner, href string) string { return "[a " + href + ":" + inner + "]" }
+func (tagRenderer) Break() string                  { return "|" }
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name, input, expected string
+	}{
+		{"plain", "plain text", "plain text"},
+		{"tags", `<b>b</b><strong>s</strong><i>i</i><em>e</em><u class="cdx-underline">u</u><s>s</s><del>d</del><mark class="cdx-marker">m</mark>`, "[b:b][b:s][i:i][i:e][u:u][s:s][s:d][mark:m]"},
+		{"nesting", "<b>bold <i>both <u>all</u></i></b> none", "[b:bold [i:both [u:all]]] none"},
+		{"misnested", "<b>a<i>b</b>c</i>", "[b:a[i:b]]c"},
+		{"unclosed", "<b>open", "[b:open]"},
+		{"unknown tags", `<span class="x">kept</span><img src="x.png">`, "kept"},
+		{"breaks", "a<br>b<br/>c", "a|b|c"},
+		{"nbsp", "a&nbsp;b", "a b"},
+		{"entities", "&lt;b&gt; &amp;amp; &#169;", "<b> &amp; ©"},
+		{"code is plain", `<code class="inline-code">a <b>b</b> &lt;c&gt;</code>`, "[code:a b <c>]"},
+		{"whitespace only", "<b> </b><i></i>x", " x"},
+		{"link", `<a href="https://example.com/?a=1&amp;b=2">link</a>`, "[a https://example.com/?a=1&b=2:link]"},
+		{"empty href", `<a href="">text</a><a>bare</a>`, "[a :text][a :bare]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			is.New(t).Equal(Convert(test.input, tagRenderer{}), test.expected)
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(PlainText(Parse(`<b>bold <a href="x">link</a></b><br>next&nbsp;line`)), "bold link\nnext line")
+}
+
+func TestWrap(t *testing.T) {
+	is := is.New(t)
+
+	tests := []struct {
+		inner, expected string
+	}{
+		{"x", "*x*"},
+		{" x ", " *x* "},
+		{"\tx y\n", "\t*x y*\n"},
+	}
+
+	for _, test := range tests {
+		is.Equal(Wrap(test.inner, "*", "*"), test.expected)
+	}
+}
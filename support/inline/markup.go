@@ -0,0 +1,84 @@
+package inline
+
+import (
+	"strings"
+)
+
+// Markup renders inline nodes for lightweight markup languages described by
+// their delimiters, such as AsciiDoc and reStructuredText.
+type Markup struct {
+	Escape func(text string) string
+	// Delimiters holds the opening and closing delimiters of each kind; kinds
+	// without delimiters are rendered as their content.
+	Delimiters map[Kind][2]string
+	Code       func(text string) string
+	Link       func(inner, href string) string
+	Break      string
+	// Boundaries are written before and after every delimited span, for
+	// languages that only recognise markup at word boundaries.
+	Boundaries [2]string
+	// Flat renders the content of delimited spans as plain text, for
+	// languages that can't nest inline markup.
+	Flat bool
+}
+
+func (m Markup) Convert(s string) string {
+	return m.Render(Parse(s))
+}
+
+func (m Markup) Render(nodes []Node) string {
+	var result strings.Builder
+
+	for _, node := range nodes {
+		switch node.Kind {
+		case Text:
+			result.WriteString(m.Escape(node.Text))
+		case Break:
+			result.WriteString(m.Break)
+		case Code:
+			text := PlainText(node.Children)
+			if strings.TrimSpace(text) == "" {
+				result.WriteString(m.Escape(text))
+				continue
+			}
+			result.WriteString(m.Boundaries[0] + m.Code(text) + m.Boundaries[1])
+		case Link:
+			inner := m.inner(node)
+			if node.Href == "" || strings.TrimSpace(inner) == "" {
+				result.WriteString(inner)
+				continue
+			}
+			result.WriteString(m.Boundaries[0] + m.Link(inner, node.Href) + m.Boundaries[1])
+		default:
+			delimiters, ok := m.Delimiters[node.Kind]
+			if !ok {
+				result.WriteString(m.Render(node.Children))
+				continue
+			}
+			inner := m.inner(node)
+			if strings.TrimSpace(inner) == "" {
+				result.WriteString(inner)
+				continue
+			}
+			result.WriteString(Wrap(inner, m.Boundaries[0]+delimiters[0], delimiters[1]+m.Boundaries[1]))
+		}
+	}
+
+	return result.String()
+}
+
+func (m Markup) inner(node Node) string {
+	if m.Flat {
+		return m.Escape(PlainText(node.Children))
+	}
+	return m.Render(node.Children)
+}
+
+// Wrap places the delimiters around the inner text, keeping surrounding
+// whitespace outside so that the markup stays valid.
+func Wrap(inner, open, close string) string {
+	trimmed := strings.TrimSpace(inner)
+	start := strings.Index(inner, trimmed)
+
+	return inner[:start] + open + trimmed + close + inner[start+len(trimmed):]
+}
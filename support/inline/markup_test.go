@@ -0,0 +1,53 @@
+package inline
+
+import (
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+)
+
+var testMarkup = Markup{
+	Escape: strings.NewReplacer("*", `\*`, "_", `\_`).Replace,
+	Delimiters: map[Kind][2]string{
+		Bold:   {"*", "*"},
+		Italic: {"_", "_"},
+	},
+	Code:  func(text string) string { return "``" + text + "``" },
+	Link:  func(inner, href string) string { return inner + " <" + href + ">" },
+	Break: "\n",
+}
+
+func TestMarkup(t *testing.T) {
+	boundaries := testMarkup
+	boundaries.Boundaries = [2]string{"(", ")"}
+
+	flat := boundaries
+	flat.Flat = true
+
+	tests := []struct {
+		name     string
+		markup   Markup
+		input    string
+		expected string
+	}{
+		{"escape", testMarkup, "a*b_c", `a\*b\_c`},
+		{"nesting", testMarkup, "<b>bold <i>both</i></b>", "*bold _both_*"},
+		{"whitespace outside delimiters", testMarkup, "a<b> bold </b>b", "a *bold* b"},
+		{"whitespace only", testMarkup, "<b> </b>", " "},
+		{"no delimiters", testMarkup, "<u>under <b>bold</b></u>", "under *bold*"},
+		{"code", testMarkup, "<code>a*b</code> <code> </code>", "``a*b``  "},
+		{"link", testMarkup, `<a href="https://example.com">a_b</a>`, `a\_b <https://example.com>`},
+		{"empty href", testMarkup, `<a href="">text</a>`, "text"},
+		{"break", testMarkup, "a<br>b", "a\nb"},
+		{"boundaries", boundaries, "x<b>bold</b>y <code>c</code> <a href=\"h\">l</a>", "x(*bold*)y (``c``) (l <h>)"},
+		{"nested boundaries", boundaries, "<b>a <i>b</i></b>", "(*a (_b_)*)"},
+		{"flat", flat, "<b>a <i>b_</i></b>", `(*a b\_*)`},
+		{"flat link", flat, `<a href="h"><b>bold</b></a>`, "(bold <h>)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			is.New(t).Equal(test.markup.Convert(test.input), test.expected)
+		})
+	}
+}
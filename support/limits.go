@@ -0,0 +1,217 @@
+package support
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"io"
+	"strings"
+)
+
+// Limits bounds the resources a single document may use. A zero value for
+// any field disables that particular check.
+type Limits struct {
+	MaxInputBytes int
+	MaxBlocks     int
+	MaxListDepth  int
+	MaxTableCells int
+	MaxTextLength int
+}
+
+// DefaultLimits are used by the Parser functions of the parser packages and
+// by the package-level wrappers. MaxInputBytes also bounds the memory a block
+// takes before the per-block checks run on it.
+var DefaultLimits = Limits{
+	MaxInputBytes: 5 << 20,
+	MaxBlocks:     10000,
+	MaxListDepth:  16,
+	MaxTableCells: 10000,
+	MaxTextLength: 100000,
+}
+
+var ErrLimitExceeded = errors.New("editorjs: limit exceeded")
+
+type LimitError struct {
+	Limit     string
+	BlockType string
+	Max       int
+	Actual    int
+}
+
+func (e *LimitError) Error() string {
+	if e.BlockType != "" {
+		return fmt.Sprintf("editorjs: %s limit exceeded in %s block (%d > %d)", e.Limit, e.BlockType, e.Actual, e.Max)
+	}
+	return fmt.Sprintf("editorjs: %s limit exceeded (%d > %d)", e.Limit, e.Actual, e.Max)
+}
+
+func (e *LimitError) Is(target error) bool {
+	return target == ErrLimitExceeded
+}
+
+func ParseEditorJSONWithLimits(editorJS string, limits Limits) (result domain.EditorJS, err error) {
+	if limits.MaxInputBytes > 0 && len(editorJS) > limits.MaxInputBytes {
+		return result, &LimitError{Limit: "input bytes", Max: limits.MaxInputBytes, Actual: len(editorJS)}
+	}
+
+	dec := json.NewDecoder(strings.NewReader(editorJS))
+
+	if err = expectDelim(dec, '{'); err != nil {
+		return
+	}
+
+	for dec.More() {
+		var key string
+		if err = dec.Decode(&key); err != nil {
+			return
+		}
+
+		switch key {
+		case "time":
+			err = dec.Decode(&result.Time)
+		case "version":
+			err = dec.Decode(&result.Version)
+		case "blocks":
+			result.Blocks, err = decodeBlocks(dec, limits)
+		default:
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	err = expectDelim(dec, '}')
+
+	return
+}
+
+func decodeBlocks(dec *json.Decoder, limits Limits) (blocks []domain.EditorJSBlock, err error) {
+	if err = expectDelim(dec, '['); err != nil {
+		return
+	}
+
+	for dec.More() {
+		if limits.MaxBlocks > 0 && len(blocks) >= limits.MaxBlocks {
+			return blocks, &LimitError{Limit: "block count", Max: limits.MaxBlocks, Actual: len(blocks) + 1}
+		}
+
+		var block domain.EditorJSBlock
+		if err = dec.Decode(&block); err != nil {
+			return
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	err = expectDelim(dec, ']')
+
+	return
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("editorjs: expected %q in input json, found %v", delim, token)
+	}
+
+	return nil
+}
+
+func CheckBlockLimits(block domain.EditorJSBlock, limits Limits) error {
+	data, _ := block.Data.(map[string]interface{})
+
+	switch block.Type {
+	case "list":
+		items, _ := data["items"].([]interface{})
+		if depth := listDepth(items); limits.MaxListDepth > 0 && depth > limits.MaxListDepth {
+			return &LimitError{Limit: "list depth", BlockType: block.Type, Max: limits.MaxListDepth, Actual: depth}
+		}
+	case "table":
+		rows, _ := data["content"].([]interface{})
+		cells := 0
+		for _, row := range rows {
+			columns, _ := row.([]interface{})
+			cells += len(columns)
+		}
+		if limits.MaxTableCells > 0 && cells > limits.MaxTableCells {
+			return &LimitError{Limit: "table cells", BlockType: block.Type, Max: limits.MaxTableCells, Actual: cells}
+		}
+	}
+
+	if limits.MaxTextLength > 0 {
+		if length := longestText(block.Data); length > limits.MaxTextLength {
+			return &LimitError{Limit: "text length", BlockType: block.Type, Max: limits.MaxTextLength, Actual: length}
+		}
+	}
+
+	return nil
+}
+
+func listDepth(items []interface{}) int {
+	depth := 0
+
+	for _, item := range items {
+		nested, ok := item.(map[string]interface{})
+		if !ok {
+			if depth < 1 {
+				depth = 1
+			}
+			continue
+		}
+
+		children, _ := nested["items"].([]interface{})
+		if d := listDepth(children) + 1; d > depth {
+			depth = d
+		}
+	}
+
+	return depth
+}
+
+func longestText(value interface{}) (length int) {
+	switch v := value.(type) {
+	case string:
+		length = len(v)
+	case []interface{}:
+		for _, item := range v {
+			if l := longestText(item); l > length {
+				length = l
+			}
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			if l := longestText(item); l > length {
+				length = l
+			}
+		}
+	}
+
+	return
+}
+
+func ReadJsonWithLimits(r io.Reader, limits Limits) (jsonData string, err error) {
+	if limits.MaxInputBytes > 0 {
+		r = io.LimitReader(r, int64(limits.MaxInputBytes)+1)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+
+	if limits.MaxInputBytes > 0 && len(data) > limits.MaxInputBytes {
+		return "", &LimitError{Limit: "input bytes", Max: limits.MaxInputBytes, Actual: len(data)}
+	}
+
+	jsonData = string(data)
+
+	return
+}
@@ -0,0 +1,61 @@
+package support
+
+import (
+	"errors"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+)
+
+func TestParseEditorJSONWithLimits(t *testing.T) {
+	is := is.New(t)
+
+	input := `{"time": 1, "blocks": [{"type": "paragraph", "data": {"text": "a"}}, {"type": "delimiter", "data": {}}], "version": "2.22"}`
+
+	editorJSON, err := ParseEditorJSONWithLimits(input, Limits{})
+	is.NoErr(err)
+	is.Equal(len(editorJSON.Blocks), 2) // all blocks are decoded without limits
+
+	_, err = ParseEditorJSONWithLimits(input, Limits{MaxBlocks: 1})
+	is.True(errors.Is(err, ErrLimitExceeded)) // block count is limited
+
+	_, err = ParseEditorJSONWithLimits(input, Limits{MaxInputBytes: 10})
+	is.True(errors.Is(err, ErrLimitExceeded)) // input size is limited
+
+	var limitErr *LimitError
+	is.True(errors.As(err, &limitErr))
+	is.Equal(limitErr.Limit, "input bytes")
+
+	_, err = ParseEditorJSONWithLimits(`{"blocks": {}}`, Limits{})
+	is.True(err != nil)                        // malformed documents are reported
+	is.True(!errors.Is(err, ErrLimitExceeded)) // but not as limit errors
+}
+
+func TestCheckBlockLimits(t *testing.T) {
+	is := is.New(t)
+
+	nested := `{"content": "leaf", "items": []}`
+	for i := 0; i < 5; i++ {
+		nested = `{"content": "level", "items": [` + nested + `]}`
+	}
+
+	input := `{"blocks": [
+		{"type": "list", "data": {"style": "ordered", "items": [` + nested + `]}},
+		{"type": "table", "data": {"content": [["a", "b"], ["c", "d"]]}},
+		{"type": "paragraph", "data": {"text": "` + strings.Repeat("x", 50) + `"}}
+	]}`
+
+	editorJSON, err := ParseEditorJSONWithLimits(input, Limits{})
+	is.NoErr(err)
+
+	list, table, paragraph := editorJSON.Blocks[0], editorJSON.Blocks[1], editorJSON.Blocks[2]
+
+	is.NoErr(CheckBlockLimits(list, Limits{MaxListDepth: 6}))
+	is.True(errors.Is(CheckBlockLimits(list, Limits{MaxListDepth: 5}), ErrLimitExceeded)) // list depth
+
+	is.NoErr(CheckBlockLimits(table, Limits{MaxTableCells: 4}))
+	is.True(errors.Is(CheckBlockLimits(table, Limits{MaxTableCells: 3}), ErrLimitExceeded)) // table cells
+
+	is.NoErr(CheckBlockLimits(paragraph, Limits{MaxTextLength: 50}))
+	is.True(errors.Is(CheckBlockLimits(paragraph, Limits{MaxTextLength: 49}), ErrLimitExceeded)) // text length
+}
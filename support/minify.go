@@ -0,0 +1,72 @@
+package support
+
+import (
+	"github.com/tdewolff/minify/v2"
+	"github.com/tdewolff/minify/v2/css"
+	"github.com/tdewolff/minify/v2/js"
+	"log"
+	"regexp"
+	"sync"
+)
+
+var (
+	minifierOnce sync.Once
+	sharedM      *minify.M
+
+	minifiedMu  sync.RWMutex
+	minified    = map[string][]byte{}
+	minifiedGen int
+)
+
+// minifier returns the minifier shared by every call; minify.M is safe for
+// concurrent use once its functions are registered.
+func minifier() *minify.M {
+	minifierOnce.Do(func() {
+		sharedM = minify.New()
+		sharedM.AddFunc("text/css", css.Minify)
+		sharedM.AddFuncRegexp(regexp.MustCompile("^(application|text)/(x-)?(java|ecma)script$"), js.Minify)
+	})
+
+	return sharedM
+}
+
+// cachedMinify minifies an asset the first time it is requested and serves
+// later requests from memory. Missing files are cached as empty content.
+func cachedMinify(assetPath, assetType string) []byte {
+	key := assetType + ":" + assetPath
+
+	minifiedMu.RLock()
+	content, ok := minified[key]
+	gen := minifiedGen
+	minifiedMu.RUnlock()
+
+	if ok {
+		return content
+	}
+
+	contentFile, err := ReadAssetFile(assetPath)
+	if err == nil {
+		content, err = MinifyContent(contentFile, assetType)
+		if err != nil {
+			log.Println("Error minifying asset file\n", err)
+		}
+	}
+
+	// a reset while the asset was read means it may come from the old
+	// overlay, so it is returned but not cached
+	minifiedMu.Lock()
+	if gen == minifiedGen {
+		minified[key] = content
+	}
+	minifiedMu.Unlock()
+
+	return content
+}
+
+func resetMinified() {
+	minifiedMu.Lock()
+	defer minifiedMu.Unlock()
+
+	minified = map[string][]byte{}
+	minifiedGen++
+}
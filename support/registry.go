@@ -0,0 +1,104 @@
+package support
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/banjuanshu/go-editorjs/support/config"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"sort"
+	"sync"
+)
+
+type RendererFactory func() domain.EditorJSMethods
+
+type Style struct {
+	Name     string
+	Map      domain.StyleMap
+	Renderer RendererFactory
+}
+
+// The registry itself can be used from several goroutines, but rendering
+// still sets the package-level SM to the active style map, so renders in
+// different styles must not run concurrently.
+var (
+	stylesMu sync.RWMutex
+	styles   = map[string]Style{}
+)
+
+func init() {
+	config.StyleLister = Styles
+}
+
+// RegisterStyle makes a style available to the HTML parser under name,
+// replacing any style previously registered with the same name. A nil
+// renderer means the map is rendered by the generic renderer.
+func RegisterStyle(name string, styleMap domain.StyleMap, renderer RendererFactory) error {
+	if name == "" {
+		return errors.New("editorjs: style name is empty")
+	}
+
+	styleMap, err := CloneStyleMap(styleMap)
+	if err != nil {
+		return err
+	}
+
+	styleMap.StyleName = name
+
+	stylesMu.Lock()
+	defer stylesMu.Unlock()
+
+	styles[name] = Style{Name: name, Map: styleMap, Renderer: renderer}
+
+	return nil
+}
+
+func LookupStyle(name string) (style Style, ok bool) {
+	stylesMu.RLock()
+	defer stylesMu.RUnlock()
+
+	style, ok = styles[name]
+	if ok {
+		// Registered maps were cloned once already, so this can't fail.
+		style.Map, _ = CloneStyleMap(style.Map)
+	}
+
+	return
+}
+
+func Styles() []string {
+	stylesMu.RLock()
+	defer stylesMu.RUnlock()
+
+	names := make([]string, 0, len(styles))
+	for name := range styles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func StyleMapAsset(path string) (styleMap domain.StyleMap, err error) {
+	content, err := ReadAssetFile(config.AssetsMapPath + path)
+	if err != nil {
+		return
+	}
+
+	if err = json.Unmarshal(content, &styleMap); err != nil {
+		err = fmt.Errorf("editorjs: style map %s: %w", path, err)
+	}
+
+	return
+}
+
+// CloneStyleMap returns a deep copy so a rendering can't leak changes to its
+// maps back into the registry.
+func CloneStyleMap(styleMap domain.StyleMap) (clone domain.StyleMap, err error) {
+	content, err := json.Marshal(styleMap)
+	if err == nil {
+		err = json.Unmarshal(content, &clone)
+	}
+
+	return
+}
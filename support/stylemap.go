@@ -0,0 +1,190 @@
+package support
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"gopkg.in/yaml.v3"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	StyleMapJSON = "json"
+	StyleMapYAML = "yaml"
+	StyleMapTOML = "toml"
+)
+
+func StyleMapFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return StyleMapYAML
+	case ".toml":
+		return StyleMapTOML
+	}
+	return StyleMapJSON
+}
+
+// ParseStyleMap decodes a style map in any of the supported formats. When
+// the map extends a registered style, its keys are merged over the base map.
+func ParseStyleMap(content []byte, format string) (styleMap domain.StyleMap, err error) {
+	doc, err := styleMapDocument(content, format)
+	if err != nil {
+		return
+	}
+
+	merged, err := json.Marshal(doc)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(merged, &styleMap)
+
+	return
+}
+
+// ValidateStyleMap reports the keys the style map sets that the renderers
+// don't know about and the keys it neither sets nor inherits.
+func ValidateStyleMap(content []byte, format string) (unknown, missing []string, err error) {
+	doc, err := styleMapDocument(content, format)
+	if err != nil {
+		return
+	}
+
+	unknown, missing = compareKeys("", doc, reflect.TypeOf(domain.StyleMap{}))
+	sort.Strings(unknown)
+	sort.Strings(missing)
+
+	return
+}
+
+func styleMapDocument(content []byte, format string) (doc map[string]interface{}, err error) {
+	switch format {
+	case StyleMapYAML:
+		err = yaml.Unmarshal(content, &doc)
+	case StyleMapTOML:
+		err = toml.Unmarshal(content, &doc)
+	case StyleMapJSON, "":
+		err = json.Unmarshal(content, &doc)
+	default:
+		err = fmt.Errorf("editorjs: unsupported style map format: %s", format)
+	}
+	if err != nil {
+		return
+	}
+
+	base, _ := doc["extends"].(string)
+	if base == "" {
+		return
+	}
+
+	style, ok := LookupStyle(base)
+	if !ok {
+		return nil, fmt.Errorf("editorjs: style map extends unknown style: %s", base)
+	}
+
+	baseContent, err := json.Marshal(style.Map)
+	if err != nil {
+		return
+	}
+
+	var baseDoc map[string]interface{}
+	if err = json.Unmarshal(baseContent, &baseDoc); err != nil {
+		return
+	}
+
+	if _, ok := doc["styleName"]; !ok {
+		doc["styleName"] = base
+	}
+
+	return mergeDocuments(baseDoc, doc), nil
+}
+
+func mergeDocuments(base, override map[string]interface{}) map[string]interface{} {
+	for key, value := range override {
+		baseValue, baseIsMap := base[key].(map[string]interface{})
+		overrideValue, overrideIsMap := value.(map[string]interface{})
+
+		if baseIsMap && overrideIsMap {
+			base[key] = mergeDocuments(baseValue, overrideValue)
+		} else {
+			base[key] = value
+		}
+	}
+
+	return base
+}
+
+func pruneEmpty(doc map[string]interface{}) map[string]interface{} {
+	for key, value := range doc {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if len(pruneEmpty(v)) == 0 {
+				delete(doc, key)
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				delete(doc, key)
+			}
+		case string:
+			if v == "" {
+				delete(doc, key)
+			}
+		case bool:
+			if !v {
+				delete(doc, key)
+			}
+		case nil:
+			delete(doc, key)
+		}
+	}
+
+	return doc
+}
+
+func compareKeys(prefix string, doc map[string]interface{}, t reflect.Type) (unknown, missing []string) {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		name, options, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if options == "omitempty" {
+			name += ",omitempty"
+		}
+		fields[name] = t.Field(i)
+	}
+
+	for key := range doc {
+		_, required := fields[key]
+		_, optional := fields[key+",omitempty"]
+		if !required && !optional {
+			unknown = append(unknown, prefix+key)
+		}
+	}
+
+	for name, field := range fields {
+		key := strings.TrimSuffix(name, ",omitempty")
+		optional := key != name
+
+		value, ok := doc[key]
+		if !ok {
+			if !optional {
+				missing = append(missing, prefix+key)
+			}
+			continue
+		}
+
+		nested, isMap := value.(map[string]interface{})
+		if field.Type.Kind() == reflect.Struct && isMap {
+			u, m := compareKeys(prefix+key+".", nested, field.Type)
+			unknown = append(unknown, u...)
+			missing = append(missing, m...)
+		}
+	}
+
+	return
+}
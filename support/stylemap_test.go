@@ -0,0 +1,105 @@
+package support
+
+import (
+	"github.com/banjuanshu/go-editorjs/support/domain"
+	"github.com/matryer/is"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func registerBase(is *is.I) {
+	base, err := StyleMapAsset("bootstrap.json")
+	is.NoErr(err)
+	is.NoErr(RegisterStyle("base", base, nil))
+}
+
+func TestParseStyleMapExtends(t *testing.T) {
+	is := is.New(t)
+
+	registerBase(is)
+
+	yamlMap := `
+extends: base
+blocks:
+  paragraph: lead
+  alert:
+    types:
+      danger: alert-danger fw-bold
+`
+
+	tomlMap := `
+extends = "base"
+
+[blocks]
+paragraph = "lead"
+
+[blocks.alert.types]
+danger = "alert-danger fw-bold"
+`
+
+	for format, content := range map[string]string{StyleMapYAML: yamlMap, StyleMapTOML: tomlMap} {
+		styleMap, err := ParseStyleMap([]byte(content), format)
+		is.NoErr(err)
+
+		is.Equal(styleMap.StyleName, "base")                                    // style name is inherited
+		is.Equal(styleMap.Blocks.Paragraph, "lead")                             // overridden key
+		is.Equal(styleMap.Blocks.Alert.Types["danger"], "alert-danger fw-bold") // overridden nested key
+		is.Equal(styleMap.Blocks.Alert.Types["info"], "alert-info")             // inherited nested key
+		is.Equal(styleMap.Blocks.Table.Table, "table table-striped")            // inherited key
+
+		unknown, missing, err := ValidateStyleMap([]byte(content), format)
+		is.NoErr(err)
+		is.Equal(len(unknown), 0)
+		is.Equal(len(missing), 0)
+	}
+
+	_, err := ParseStyleMap([]byte(`{"extends": "nope"}`), StyleMapJSON)
+	is.True(err != nil) // unknown base style
+}
+
+func TestValidateStyleMap(t *testing.T) {
+	is := is.New(t)
+
+	unknown, missing, err := ValidateStyleMap([]byte(`{"styleName": "x", "colour": "red", "blocks": {"paragraph": "", "quote": {"cite": ""}}}`), StyleMapJSON)
+	is.NoErr(err)
+
+	is.Equal(unknown, []string{"blocks.quote.cite", "colour"})
+	is.True(len(missing) > 0)
+	is.Equal(missing[0], "alignment")
+
+	is.Equal(StyleMapFormat("styles/brand.YML"), StyleMapYAML)
+	is.Equal(StyleMapFormat("styles/brand.toml"), StyleMapTOML)
+	is.Equal(StyleMapFormat("styles/brand.json"), StyleMapJSON)
+}
+
+func TestLoadStyleMapSources(t *testing.T) {
+	is := is.New(t)
+
+	registerBase(is)
+
+	err := LoadStyleMapFromReader(strings.NewReader(`{"extends": "base", "blocks": {"paragraph": "from-reader"}}`), StyleMapJSON)
+	is.NoErr(err)
+	is.Equal(SM.Blocks.Paragraph, "from-reader")
+
+	fsys := fstest.MapFS{
+		"styles/brand.yaml": &fstest.MapFile{Data: []byte("extends: base\nblocks:\n  paragraph: from-fs\n")},
+	}
+
+	err = LoadStyleMapFromFS(fsys, "styles/brand.yaml")
+	is.NoErr(err)
+	is.Equal(SM.Blocks.Paragraph, "from-fs")
+
+	err = LoadStyleMapFromFS(fsys, "styles/missing.yaml")
+	is.True(err != nil) // missing files are reported
+
+	err = SetStyleMap(domain.StyleMap{Extends: "base", Blocks: domain.Blocks{Paragraph: "from-value"}})
+	is.NoErr(err)
+	is.Equal(SM.Blocks.Paragraph, "from-value")
+	is.Equal(SM.Blocks.Table.Table, "table table-striped") // zero values don't override the base
+
+	err = SetStyleMap(domain.StyleMap{StyleName: "value", Blocks: domain.Blocks{Paragraph: "plain"}})
+	is.NoErr(err)
+	is.Equal(SM.StyleName, "value")
+	is.Equal(SM.Blocks.Table.Table, "")
+}
@@ -6,14 +6,12 @@ import (
 	"fmt"
 	"github.com/banjuanshu/go-editorjs/support/config"
 	"github.com/banjuanshu/go-editorjs/support/domain"
-	"github.com/tdewolff/minify/v2"
-	"github.com/tdewolff/minify/v2/css"
-	"github.com/tdewolff/minify/v2/js"
+	"io"
+	"io/fs"
 	"io/ioutil"
 	"log"
 	"math"
 	"os"
-	"regexp"
 	"strconv"
 	"strings"
 )
@@ -38,19 +36,11 @@ func ReadJsonFile(jsonFilePath string) (jsonData string, err error) {
 }
 
 func MinifyLib(libPath, libType string) (contentMinified []byte) {
-	contentFile, err := libsFiles.ReadFile("libs/" + libPath)
-	if err == nil {
-		contentMinified, err = MinifyContent(contentFile, libType)
-		if err != nil {
-			log.Println("Error minifying lib file\n", err)
-		}
-	}
-
-	return
+	return cachedMinify(config.LibsPath+libPath, libType)
 }
 
 func LoadAsset(assetFile, assetType string) (contentFile []byte) {
-	contentFile, err := assetsFiles.ReadFile("assets/" + assetType + "/" + assetFile)
+	contentFile, err := ReadAssetFile("assets/" + assetType + "/" + assetFile)
 	if err != nil {
 		log.Println("Error loading asset file\n", err)
 	}
@@ -59,15 +49,7 @@ func LoadAsset(assetFile, assetType string) (contentFile []byte) {
 }
 
 func MinifyAsset(assetPath, assetType string) (contentMinified []byte) {
-	contentFile, err := assetsFiles.ReadFile(assetPath)
-	if err == nil {
-		contentMinified, err = MinifyContent(contentFile, assetType)
-		if err != nil {
-			log.Println("Error minifying asset file\n", err)
-		}
-	}
-
-	return
+	return cachedMinify(assetPath, assetType)
 }
 
 func MinifyExternalStyle(libPath string) (contentMinified []byte, err error) {
@@ -84,19 +66,17 @@ func MinifyExternalStyle(libPath string) (contentMinified []byte, err error) {
 
 func MinifyContent(content []byte, format string) (contentMinified []byte, err error) {
 
-	m := minify.New()
+	m := minifier()
 
 	switch format {
 
 	case "css":
-		m.AddFunc("text/css", css.Minify)
 		contentMinified, err = m.Bytes("text/css", content)
 		if err != nil {
 			log.Println("Error minifying CSS file\n", err)
 		}
 
 	case "js":
-		m.AddFuncRegexp(regexp.MustCompile("^(application|text)/(x-)?(java|ecma)script$"), js.Minify)
 		contentMinified, err = m.Bytes("application/javascript", content)
 		if err != nil {
 			log.Println("Error minifying JS file\n", err)
@@ -125,13 +105,14 @@ func CreateHTMLNestedList(items []domain.NestedListItem, listStyle string, first
 	}
 
 	for _, item := range items {
-		result = append(result, `<li class="`+SM.Blocks.List.Item+`">`+item.Content+`</li>`)
-
-		if len(item.Items) > 0 {
-			result = append(result, CreateHTMLNestedList(item.Items, listStyle, false))
+		if len(item.Items) == 0 {
+			result = append(result, `<li class="`+SM.Blocks.List.Item+`">`+item.Content+`</li>`)
+			continue
 		}
 
-		result = append(result, `</li>`)
+		result = append(result, `<li class="`+SM.Blocks.List.Item+`">`+item.Content,
+			CreateHTMLNestedList(item.Items, listStyle, false),
+			`</li>`)
 	}
 
 	result = append(result, `</`+listStyle+`>`)
@@ -143,15 +124,26 @@ func CreateMarkDownNestedList(items []domain.NestedListItem, listStyle, spaceLef
 	var result []string
 
 	for i, item := range items {
-		if listStyle == "unordered" {
-			result = append(result, spaceLeft+"- "+fmt.Sprintf("%v", item.Content))
-		} else {
-			n := spaceLeft + strconv.Itoa(i+1) + "."
-			result = append(result, fmt.Sprintf("%s %s", n, item.Content))
+		marker := "-"
+		if listStyle != "unordered" {
+			marker = strconv.Itoa(i+1) + "."
 		}
 
+		// continuation lines are indented up to the item content so they
+		// don't end the item or break the nesting of the following lists
+		continuation := "\n" + strings.Repeat(" ", len(spaceLeft)+len(marker)+1)
+		content := strings.ReplaceAll(fmt.Sprintf("%v", item.Content), "\n", continuation)
+
+		result = append(result, fmt.Sprintf("%s%s %s", spaceLeft, marker, content))
+
 		if len(item.Items) > 0 {
-			result = append(result, CreateMarkDownNestedList(item.Items, listStyle, spaceLeft+"    "))
+			// nested lists start at the item content at least, which is
+			// past four columns for markers such as "100."
+			indent := len(marker) + 1
+			if indent < 4 {
+				indent = 4
+			}
+			result = append(result, CreateMarkDownNestedList(item.Items, listStyle, spaceLeft+strings.Repeat(" ", indent)))
 		}
 
 	}
@@ -268,6 +260,7 @@ func ParseEditorJSON(editorJS string) domain.EditorJS {
 func LoadStyleMap(path string) {
 	content := LoadAsset(path, "json")
 
+	SM = domain.StyleMap{}
 	err := json.Unmarshal(content, &SM)
 	if err != nil {
 		log.Fatal("Error unmarshalling the style config json file\n", err)
@@ -282,14 +275,86 @@ func LoadExternalStyleMap(path string) {
 		log.Println("Error reading the external style file\n", err)
 	}
 
-	err = json.Unmarshal(content, &SM)
-	if err != nil {
-		log.Fatal("Error unmarshalling the style config json file\n", err)
+	if err = loadStyleMapContent(content, StyleMapFormat(path)); err != nil {
+		log.Fatal("Error unmarshalling the style config file\n", err)
 	}
 
 	return
 }
 
+func LoadStyleMapFromReader(r io.Reader, format string) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	return loadStyleMapContent(content, format)
+}
+
+func LoadStyleMapFromFS(fsys fs.FS, path string) error {
+	content, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return err
+	}
+
+	return loadStyleMapContent(content, StyleMapFormat(path))
+}
+
+// SetStyleMap uses a style map built in Go, resolving its base style when it
+// extends one.
+func SetStyleMap(styleMap domain.StyleMap) error {
+	if styleMap.Extends == "" {
+		clone, err := CloneStyleMap(styleMap)
+		if err != nil {
+			return err
+		}
+		SM = clone
+		return nil
+	}
+
+	content, err := json.Marshal(styleMap)
+	if err != nil {
+		return err
+	}
+
+	var doc map[string]interface{}
+	if err = json.Unmarshal(content, &doc); err != nil {
+		return err
+	}
+
+	// Zero values in a Go map mean "not set", so they must not override the
+	// keys inherited from the base style.
+	if content, err = json.Marshal(pruneEmpty(doc)); err != nil {
+		return err
+	}
+
+	return loadStyleMapContent(content, StyleMapJSON)
+}
+
+func loadStyleMapContent(content []byte, format string) error {
+	unknown, missing, err := ValidateStyleMap(content, format)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range unknown {
+		log.Println("Unknown key in the style config file:", key)
+	}
+
+	for _, key := range missing {
+		log.Println("Missing key in the style config file:", key)
+	}
+
+	styleMap, err := ParseStyleMap(content, format)
+	if err != nil {
+		return err
+	}
+
+	SM = styleMap
+
+	return nil
+}
+
 func AppendBlockScript(blockScript string) (blockScriptOut string) {
 	blockScriptMinified, _ := MinifyContent([]byte(blockScript), "js")
 	blockScriptOut = string(blockScriptMinified)
@@ -297,10 +362,6 @@ func AppendBlockScript(blockScript string) (blockScriptOut string) {
 }
 
 func IsValidStyle(style string) bool {
-	for _, s := range config.AvailableStyles() {
-		if s == style {
-			return true
-		}
-	}
-	return false
+	_, ok := LookupStyle(style)
+	return ok
 }